@@ -3,14 +3,17 @@ package object
 import (
 	"fmt"
 	"reflect"
+	"strings"
 
 	"github.com/samber/lo"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes/scheme"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/yaml"
 )
 
@@ -37,10 +40,48 @@ func (gvknn GroupVersionKindNamespacedName) String() string {
 	return str
 }
 
+// GVK returns the GroupVersionKind of the object, resolved with the scheme. Types served by aggregated API servers
+// resolve like CRDs, as long as their Go types are registered with the scheme.
 func GVK(o runtime.Object) schema.GroupVersionKind {
 	return lo.Must(apiutil.GVKForObject(o, scheme.Scheme))
 }
 
+// KindString returns the lowercased kind of the object, e.g. for naming controllers and metrics. Typed objects are
+// resolved with the scheme, while unstructured objects use their own kind.
+func KindString(o client.Object) string {
+	return strings.ToLower(GVK(o).Kind)
+}
+
+// GVKs returns the GroupVersionKind of each object, in order
+func GVKs(objs ...client.Object) []schema.GroupVersionKind {
+	return lo.Map(objs, func(o client.Object, _ int) schema.GroupVersionKind { return GVK(o) })
+}
+
+// OwnerRef returns the controlling owner of the object, or nil if the object has no controlling owner
+func OwnerRef(o client.Object) *metav1.OwnerReference {
+	return metav1.GetControllerOf(o)
+}
+
+// IsBeingDeleted returns true if deletion of the object has been requested, e.g. it's waiting on finalizers
+func IsBeingDeleted(o client.Object) bool {
+	return o.GetDeletionTimestamp() != nil
+}
+
+// HasFinalizer returns true if the object has the finalizer
+func HasFinalizer(o client.Object, finalizer string) bool {
+	return controllerutil.ContainsFinalizer(o, finalizer)
+}
+
+// AddFinalizer adds the finalizer to the object if it's not present, returning true if the object was modified
+func AddFinalizer(o client.Object, finalizer string) bool {
+	return controllerutil.AddFinalizer(o, finalizer)
+}
+
+// RemoveFinalizer removes the finalizer from the object if it's present, returning true if the object was modified
+func RemoveFinalizer(o client.Object, finalizer string) bool {
+	return controllerutil.RemoveFinalizer(o, finalizer)
+}
+
 func New[T any]() T {
 	return reflect.New(reflect.TypeOf(*new(T)).Elem()).Interface().(T)
 }
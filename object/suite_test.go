@@ -0,0 +1,63 @@
+package object_test
+
+import (
+	"testing"
+
+	"github.com/awslabs/operatorpkg/object"
+	"github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/samber/lo"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func Test(t *testing.T) {
+	RegisterFailHandler(ginkgo.Fail)
+	ginkgo.RunSpecs(t, "Object")
+}
+
+var _ = Describe("Object", func() {
+	It("should resolve the GVKs of a heterogeneous slice of objects in order", func() {
+		Expect(object.GVKs(&corev1.Pod{}, &appsv1.Deployment{}, &corev1.Node{}, &corev1.Pod{})).To(Equal([]schema.GroupVersionKind{
+			{Version: "v1", Kind: "Pod"},
+			{Group: "apps", Version: "v1", Kind: "Deployment"},
+			{Version: "v1", Kind: "Node"},
+			{Version: "v1", Kind: "Pod"},
+		}))
+		Expect(object.GVKs([]client.Object{}...)).To(BeEmpty())
+	})
+	It("should detect objects being deleted", func() {
+		pod := &corev1.Pod{}
+		Expect(object.IsBeingDeleted(pod)).To(BeFalse())
+		pod.DeletionTimestamp = lo.ToPtr(metav1.Now())
+		Expect(object.IsBeingDeleted(pod)).To(BeTrue())
+	})
+	It("should detect finalizers", func() {
+		pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Finalizers: []string{"example.com/finalizer"}}}
+		Expect(object.HasFinalizer(pod, "example.com/finalizer")).To(BeTrue())
+		Expect(object.HasFinalizer(pod, "example.com/other")).To(BeFalse())
+	})
+	It("should add finalizers only if not present", func() {
+		pod := &corev1.Pod{}
+		Expect(object.AddFinalizer(pod, "example.com/finalizer")).To(BeTrue())
+		Expect(object.AddFinalizer(pod, "example.com/finalizer")).To(BeFalse())
+		Expect(pod.Finalizers).To(Equal([]string{"example.com/finalizer"}))
+	})
+	It("should remove finalizers only if present", func() {
+		pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Finalizers: []string{"example.com/finalizer", "example.com/other"}}}
+		Expect(object.RemoveFinalizer(pod, "example.com/finalizer")).To(BeTrue())
+		Expect(object.RemoveFinalizer(pod, "example.com/finalizer")).To(BeFalse())
+		Expect(pod.Finalizers).To(Equal([]string{"example.com/other"}))
+	})
+	It("should return the lowercased kind of typed and unstructured objects", func() {
+		Expect(object.KindString(&appsv1.Deployment{})).To(Equal("deployment"))
+		deployment := &unstructured.Unstructured{}
+		deployment.SetGroupVersionKind(schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"})
+		Expect(object.KindString(deployment)).To(Equal("deployment"))
+	})
+})
@@ -0,0 +1,49 @@
+package status
+
+import (
+	"slices"
+	"time"
+
+	"github.com/samber/lo"
+)
+
+// Availability returns the fraction of the window ending at now for which the root condition was True, computed
+// from the transition history of a single object, e.g. as collected by an EventSink. Transitions of conditions
+// other than the root, i.e. Ready or Succeeded, are ignored. The status at the start of the window is that of the
+// last transition before the window, or otherwise the previous status of the first transition within the window.
+// Returns 0 if the history has no transitions of the root condition.
+func Availability(history []TransitionEvent, window time.Duration, now time.Time) float64 {
+	transitions := lo.Filter(history, func(event TransitionEvent, _ int) bool {
+		return event.Current.Type == ConditionReady || event.Current.Type == ConditionSucceeded
+	})
+	if window <= 0 || len(transitions) == 0 {
+		return 0
+	}
+	slices.SortStableFunc(transitions, func(a, b TransitionEvent) int {
+		return a.Current.LastTransitionTime.Time.Compare(b.Current.LastTransitionTime.Time)
+	})
+	start := now.Add(-window)
+	available := transitions[0].Previous.IsTrue()
+	for _, event := range transitions {
+		if event.Current.LastTransitionTime.Time.After(start) {
+			break
+		}
+		available = event.Current.IsTrue()
+	}
+	var availableFor time.Duration
+	since := start
+	for _, event := range transitions {
+		transitioned := event.Current.LastTransitionTime.Time
+		if !transitioned.After(start) || transitioned.After(now) {
+			continue
+		}
+		if available {
+			availableFor += transitioned.Sub(since)
+		}
+		available, since = event.Current.IsTrue(), transitioned
+	}
+	if available {
+		availableFor += now.Sub(since)
+	}
+	return availableFor.Seconds() / window.Seconds()
+}
@@ -0,0 +1,49 @@
+package status_test
+
+import (
+	"time"
+
+	"github.com/awslabs/operatorpkg/status"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("Availability", func() {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	transition := func(conditionType string, from, to metav1.ConditionStatus, ago time.Duration) status.TransitionEvent {
+		return status.TransitionEvent{
+			Previous: status.Condition{Type: conditionType, Status: from},
+			Current:  status.Condition{Type: conditionType, Status: to, LastTransitionTime: metav1.NewTime(now.Add(-ago))},
+		}
+	}
+	DescribeTable("should compute the fraction of the window the root condition was True",
+		func(history []status.TransitionEvent, availability float64) {
+			Expect(status.Availability(history, time.Hour, now)).To(BeNumerically("~", availability, 1e-9))
+		},
+		Entry("no history", nil, 0.0),
+		Entry("ready before the window", []status.TransitionEvent{
+			transition(status.ConditionReady, metav1.ConditionUnknown, metav1.ConditionTrue, 2*time.Hour),
+		}, 1.0),
+		Entry("ready within the window", []status.TransitionEvent{
+			transition(status.ConditionReady, metav1.ConditionUnknown, metav1.ConditionTrue, 15*time.Minute),
+		}, 0.25),
+		Entry("outage within the window", []status.TransitionEvent{
+			transition(status.ConditionReady, metav1.ConditionUnknown, metav1.ConditionTrue, 2*time.Hour),
+			transition(status.ConditionReady, metav1.ConditionTrue, metav1.ConditionFalse, 30*time.Minute),
+			transition(status.ConditionReady, metav1.ConditionFalse, metav1.ConditionTrue, 15*time.Minute),
+		}, 0.75),
+		Entry("unordered history", []status.TransitionEvent{
+			transition(status.ConditionReady, metav1.ConditionFalse, metav1.ConditionTrue, 15*time.Minute),
+			transition(status.ConditionReady, metav1.ConditionTrue, metav1.ConditionFalse, 30*time.Minute),
+		}, 0.75),
+		Entry("unavailable at the start of the window", []status.TransitionEvent{
+			transition(status.ConditionReady, metav1.ConditionFalse, metav1.ConditionTrue, 45*time.Minute),
+			transition(status.ConditionReady, metav1.ConditionTrue, metav1.ConditionUnknown, 30*time.Minute),
+		}, 0.25),
+		Entry("transitions of other conditions", []status.TransitionEvent{
+			transition(status.ConditionReady, metav1.ConditionUnknown, metav1.ConditionTrue, 2*time.Hour),
+			transition("Foo", metav1.ConditionTrue, metav1.ConditionFalse, 30*time.Minute),
+		}, 1.0),
+	)
+})
@@ -0,0 +1,67 @@
+package status
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/awslabs/operatorpkg/object"
+	"github.com/samber/lo"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// CheckpointAnnotationKey is the annotation used to persist the observed condition statuses of an object,
+// see ControllerOpts.Checkpoint
+const CheckpointAnnotationKey = "operatorpkg.k8s.aws/observed-conditions"
+
+// CheckpointTransitionTimesAnnotationKey is the annotation used to persist the LastTransitionTime of the observed
+// conditions of an object, so that transitions which occur while no controller is running can be measured
+const CheckpointTransitionTimesAnnotationKey = "operatorpkg.k8s.aws/observed-transition-times"
+
+// restoreCheckpoint returns the conditions observed by a previous controller instance. Conditions checkpointed
+// without a LastTransitionTime can only be used to detect transitions, not to measure them.
+func (c *Controller[T]) restoreCheckpoint(o T) ConditionSet {
+	value, ok := o.GetAnnotations()[CheckpointAnnotationKey]
+	if !ok {
+		return ConditionSet{}
+	}
+	statuses := map[string]metav1.ConditionStatus{}
+	if err := json.Unmarshal([]byte(value), &statuses); err != nil {
+		return ConditionSet{}
+	}
+	transitionTimes := map[string]metav1.Time{}
+	if value, ok := o.GetAnnotations()[CheckpointTransitionTimesAnnotationKey]; ok {
+		// Transition times are best effort, since they only improve the accuracy of durations
+		_ = json.Unmarshal([]byte(value), &transitionTimes)
+	}
+	restored := &memoryObject{Object: object.New[T]()}
+	restored.SetConditions(lo.MapToSlice(statuses, func(conditionType string, status metav1.ConditionStatus) Condition {
+		return Condition{Type: conditionType, Status: status, LastTransitionTime: transitionTimes[conditionType]}
+	}))
+	return ConditionSet{object: restored}
+}
+
+// checkpoint persists the observed condition statuses to an annotation on the object, so that a future controller
+// instance can accurately report the status a condition transitioned from
+func (c *Controller[T]) checkpoint(ctx context.Context, o T, observedConditions ConditionSet) error {
+	annotations := map[string]string{
+		CheckpointAnnotationKey: string(lo.Must(json.Marshal(lo.SliceToMap(observedConditions.List(), func(condition Condition) (string, metav1.ConditionStatus) {
+			return condition.Type, condition.Status
+		})))),
+		CheckpointTransitionTimesAnnotationKey: string(lo.Must(json.Marshal(lo.SliceToMap(observedConditions.List(), func(condition Condition) (string, metav1.Time) {
+			return condition.Type, condition.LastTransitionTime
+		})))),
+	}
+	if lo.EveryBy(lo.Entries(annotations), func(entry lo.Entry[string, string]) bool { return o.GetAnnotations()[entry.Key] == entry.Value }) {
+		return nil
+	}
+	// Patch a copy, since the in memory conditions of the object are still observed
+	stored := o.DeepCopyObject().(client.Object)
+	patched := o.DeepCopyObject().(client.Object)
+	patched.SetAnnotations(lo.Assign(patched.GetAnnotations(), annotations))
+	if err := c.kubeClient.Patch(ctx, patched, client.MergeFrom(stored), client.FieldOwner(FieldManager)); client.IgnoreNotFound(err) != nil {
+		return fmt.Errorf("checkpointing observed conditions, %w", err)
+	}
+	return nil
+}
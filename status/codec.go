@@ -0,0 +1,24 @@
+package status
+
+import (
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ConditionCodec adapts conditions of an arbitrary representation, e.g. a custom slice type with statuses expressed
+// as Go enums, to and from Conditions, so that objects with such types can be reconciled by the status controller
+type ConditionCodec[T client.Object] interface {
+	// Decode returns the conditions of the object
+	Decode(T) []Condition
+	// Encode sets the conditions of the object
+	Encode(T, []Condition)
+}
+
+// NewControllerWithCodec constructs a controller for a type that doesn't implement Object, using the codec
+// to get and set its conditions
+func NewControllerWithCodec[T client.Object](client client.Client, eventRecorder record.EventRecorder, codec ConditionCodec[T], opts ...ControllerOpts) *Controller[T] {
+	return NewControllerWithAccessors(client, eventRecorder, ConditionAccessors[T]{
+		GetConditions: codec.Decode,
+		SetConditions: codec.Encode,
+	}, opts...)
+}
@@ -0,0 +1,249 @@
+package status
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/samber/lo"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/awslabs/operatorpkg/object"
+)
+
+var (
+	sharedCollectors     = map[string]any{}
+	sharedCollectorsLock sync.Mutex
+)
+
+// sharedCollector adds the controller to the controllers read by the collector with the name for its kind, metric
+// namespace and labels, which is constructed and registered for the first such controller, so that controllers
+// don't each register a collector, see Controller.Unregister. Collectors are registered unchecked, since they share
+// their metric names with the controller's metrics.
+func sharedCollector[T client.Object, C interface {
+	prometheus.Collector
+	set() *controllerSet[T]
+}](name string, controller *Controller[T], newCollector func(*Controller[T]) C) {
+	sharedCollectorsLock.Lock()
+	defer sharedCollectorsLock.Unlock()
+
+	key := strings.Join([]string{name, controller.metricNamespace(), object.GVK(object.New[T]()).String(), strings.Join(controller.labelNames(), ",")}, "/")
+	collector, ok := sharedCollectors[key].(C)
+	if !ok {
+		collector = newCollector(controller)
+		lo.Must0(metrics.Registry.Register(uncheckedCollector{collector}))
+		sharedCollectors[key] = collector
+	}
+	collector.set().add(controller)
+	controller.unregisterCollectors = append(controller.unregisterCollectors, func() { collector.set().remove(controller) })
+}
+
+// controllerSet is the set of controllers read by a shared collector
+type controllerSet[T client.Object] struct {
+	mu          sync.Mutex
+	controllers []*Controller[T]
+}
+
+func (s *controllerSet[T]) set() *controllerSet[T] {
+	return s
+}
+
+func (s *controllerSet[T]) add(controller *Controller[T]) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.controllers = append(s.controllers, controller)
+}
+
+func (s *controllerSet[T]) remove(controller *Controller[T]) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.controllers = lo.Without(s.controllers, controller)
+}
+
+// each calls f with each controller and its observed conditions while holding the controller's lock. Objects
+// observed by more than one controller are only visited once.
+func (s *controllerSet[T]) each(f func(controller *Controller[T], req reconcile.Request, conditions ConditionSet)) {
+	s.mu.Lock()
+	controllers := slices.Clone(s.controllers)
+	s.mu.Unlock()
+
+	visited := map[reconcile.Request]bool{}
+	for _, controller := range controllers {
+		func() {
+			controller.mu.Lock()
+			defer controller.mu.Unlock()
+			for req, conditions := range controller.observedConditions {
+				if conditions.object == nil || visited[req] {
+					continue
+				}
+				visited[req] = true
+				f(controller, req, conditions)
+			}
+		}()
+	}
+}
+
+// currentStatusSecondsCollector computes ConditionCurrentStatusSeconds at scrape time from the conditions last
+// observed by the controller, rather than the controller setting each series on every reconcile
+type currentStatusSecondsCollector[T client.Object] struct {
+	controllerSet[T]
+	desc             *prometheus.Desc
+	annotationLabels []string
+}
+
+func newCurrentStatusSecondsCollector[T client.Object](controller *Controller[T]) *currentStatusSecondsCollector[T] {
+	// The collector emits series of the same family as the controller's gauge, which never sets them
+	descs := make(chan *prometheus.Desc, 1)
+	controller.metrics.ConditionCurrentStatusSeconds.Describe(descs)
+	return &currentStatusSecondsCollector[T]{
+		desc:             <-descs,
+		annotationLabels: controller.labelNames(),
+	}
+}
+
+func (c *currentStatusSecondsCollector[T]) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.desc
+}
+
+func (c *currentStatusSecondsCollector[T]) Collect(ch chan<- prometheus.Metric) {
+	gvk := object.GVK(object.New[T]())
+	c.each(func(controller *Controller[T], req reconcile.Request, conditions ConditionSet) {
+		now := controller.opts.Clock.Now()
+		if now.Sub(conditions.object.GetCreationTimestamp().Time) < controller.opts.MinObjectAgeForMetrics {
+			return
+		}
+		annotationLabels := controller.metricLabels(req, conditions.object)
+		for _, condition := range conditions.List() {
+			ch <- prometheus.MustNewConstMetric(c.desc, prometheus.GaugeValue, now.Sub(condition.LastTransitionTime.Time).Seconds(), append([]string{
+				req.Namespace,
+				req.Name,
+				gvk.Group,
+				gvk.Kind,
+				gvk.Version,
+				condition.Type,
+				string(condition.Status),
+			}, lo.Map(c.annotationLabels, func(label string, _ int) string { return annotationLabels[label] })...)...)
+		}
+	})
+}
+
+// reducedCardinalityCollector computes ConditionCount and ConditionCurrentStatusSeconds at scrape time without the
+// per-object namespace and name labels, aggregating the conditions last observed by the controller. Counts are summed
+// across objects, and current status seconds is the longest that any object has held the status.
+type reducedCardinalityCollector[T client.Object] struct {
+	controllerSet[T]
+	countDesc                *prometheus.Desc
+	currentStatusSecondsDesc *prometheus.Desc
+	annotationLabels         []string
+}
+
+func newReducedCardinalityCollector[T client.Object](controller *Controller[T]) *reducedCardinalityCollector[T] {
+	annotationLabels := controller.labelNames()
+	labels := append([]string{
+		MetricLabelGroup,
+		MetricLabelKind,
+		MetricLabelVersion,
+		MetricLabelConditionType,
+		MetricLabelConditionStatus,
+	}, annotationLabels...)
+	return &reducedCardinalityCollector[T]{
+		countDesc:                prometheus.NewDesc(prometheus.BuildFQName(controller.metricNamespace(), MetricSubsystem, "count"), conditionCountHelp, labels, nil),
+		currentStatusSecondsDesc: prometheus.NewDesc(prometheus.BuildFQName(controller.metricNamespace(), MetricSubsystem, "current_status_seconds"), conditionCurrentStatusSecondsHelp, labels, nil),
+		annotationLabels:         annotationLabels,
+	}
+}
+
+func (c *reducedCardinalityCollector[T]) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.countDesc
+	ch <- c.currentStatusSecondsDesc
+}
+
+func (c *reducedCardinalityCollector[T]) Collect(ch chan<- prometheus.Metric) {
+	type series struct {
+		labelValues          []string
+		count                float64
+		currentStatusSeconds float64
+		aged                 bool
+	}
+	aggregated := map[string]*series{}
+	gvk := object.GVK(object.New[T]())
+	c.each(func(controller *Controller[T], req reconcile.Request, conditions ConditionSet) {
+		now := controller.opts.Clock.Now()
+		aged := now.Sub(conditions.object.GetCreationTimestamp().Time) >= controller.opts.MinObjectAgeForMetrics
+		annotationLabels := controller.metricLabels(req, conditions.object)
+		for _, condition := range conditions.List() {
+			labelValues := append([]string{
+				gvk.Group,
+				gvk.Kind,
+				gvk.Version,
+				condition.Type,
+				string(condition.Status),
+			}, lo.Map(c.annotationLabels, func(label string, _ int) string { return annotationLabels[label] })...)
+			key := strings.Join(labelValues, ",")
+			if _, ok := aggregated[key]; !ok {
+				aggregated[key] = &series{labelValues: labelValues}
+			}
+			aggregated[key].count += controller.opts.ConditionCountValue(controller.unwrap(conditions.object), condition)
+			if aged {
+				aggregated[key].currentStatusSeconds = max(aggregated[key].currentStatusSeconds, now.Sub(condition.LastTransitionTime.Time).Seconds())
+				aggregated[key].aged = true
+			}
+		}
+	})
+	for _, s := range aggregated {
+		ch <- prometheus.MustNewConstMetric(c.countDesc, prometheus.GaugeValue, s.count, s.labelValues...)
+		if s.aged {
+			ch <- prometheus.MustNewConstMetric(c.currentStatusSecondsDesc, prometheus.GaugeValue, s.currentStatusSeconds, s.labelValues...)
+		}
+	}
+}
+
+// ownerReadyRatioCollector computes the fraction of the objects last observed by the controller whose root condition
+// is True, grouped by their controlling owner, at scrape time. Objects without a controlling owner are ignored.
+type ownerReadyRatioCollector[T client.Object] struct {
+	controllerSet[T]
+	desc *prometheus.Desc
+}
+
+func newOwnerReadyRatioCollector[T client.Object](controller *Controller[T]) *ownerReadyRatioCollector[T] {
+	return &ownerReadyRatioCollector[T]{
+		desc: prometheus.NewDesc(
+			prometheus.BuildFQName(controller.metricNamespace(), StatusMetricSubsystem, "owner_ready_ratio"),
+			"The fraction of the objects of a controlling owner that are Ready. e.g. Alarm := owner_ready_ratio < 0.5",
+			[]string{MetricLabelGroup, MetricLabelKind, MetricLabelNamespace, MetricLabelOwner},
+			nil,
+		),
+	}
+}
+
+func (c *ownerReadyRatioCollector[T]) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.desc
+}
+
+func (c *ownerReadyRatioCollector[T]) Collect(ch chan<- prometheus.Metric) {
+	type owner struct {
+		namespace string
+		name      string
+	}
+	children := map[owner]float64{}
+	ready := map[owner]float64{}
+	c.each(func(controller *Controller[T], req reconcile.Request, conditions ConditionSet) {
+		ownerRef := object.OwnerRef(controller.unwrap(conditions.object))
+		if ownerRef == nil {
+			return
+		}
+		key := owner{namespace: req.Namespace, name: fmt.Sprintf("%s/%s", ownerRef.Kind, ownerRef.Name)}
+		children[key]++
+		if conditions.IsHappy() {
+			ready[key]++
+		}
+	})
+	gvk := object.GVK(object.New[T]())
+	for key, count := range children {
+		ch <- prometheus.MustNewConstMetric(c.desc, prometheus.GaugeValue, ready[key]/count, gvk.Group, gvk.Kind, key.namespace, key.name)
+	}
+}
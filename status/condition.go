@@ -26,6 +26,28 @@ const (
 	ConditionSucceeded = "Succeeded"
 )
 
+// Condition types of the Available/Progressing/Degraded model of operator status, see OperatorStatusConditions
+const (
+	// ConditionAvailable specifies that the resource is functional and available.
+	ConditionAvailable = "Available"
+	// ConditionProgressing specifies that the resource is rolling out a change, e.g. of its version or configuration.
+	ConditionProgressing = "Progressing"
+	// ConditionDegraded specifies that the resource is in an abnormal state and requires attention.
+	ConditionDegraded = "Degraded"
+)
+
+// ConditionReason is an upper-camel-cased reason for the status of a condition. Declaring reasons as typed
+// constants, rather than free strings, avoids typos which churn the reason label of metrics. Setters accept
+// typed reasons, as well as untyped string constants, e.g. SetFalse(ConditionTypeLaunched, "LaunchFailed", message).
+type ConditionReason string
+
+const (
+	// ConditionReasonAwaitingReconciliation is the reason of a condition that hasn't been reconciled yet
+	ConditionReasonAwaitingReconciliation ConditionReason = "AwaitingReconciliation"
+	// ConditionReasonUnhealthyDependents is the reason of a root condition with dependents that aren't True
+	ConditionReasonUnhealthyDependents ConditionReason = "UnhealthyDependents"
+)
+
 // Condition aliases the upstream type and adds additional helper methods
 type Condition metav1.Condition
 
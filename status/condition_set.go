@@ -3,10 +3,13 @@
 package status
 
 import (
+	"encoding/json"
 	"fmt"
 	"reflect"
+	"slices"
 	"sort"
 	"strings"
+	"text/template"
 
 	"github.com/samber/lo"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -19,6 +22,10 @@ import (
 type ConditionTypes struct {
 	root       string
 	dependents []string
+	// dependencies maps a condition type to the condition types it depends on, beyond the dependents of the root
+	dependencies map[string][]string
+	// negativePolarity are the condition types for which True is the abnormal status
+	negativePolarity []string
 }
 
 // NewReadyConditions returns a ConditionTypes to hold the conditions for the
@@ -35,6 +42,16 @@ func NewSucceededConditions(d ...string) ConditionTypes {
 	return newConditionTypes(ConditionSucceeded, d...)
 }
 
+// OperatorStatusConditions returns a ConditionTypes to hold the conditions of a resource following the
+// Available/Progressing/Degraded model of operator status. ConditionReady is used as the root condition, which
+// is True once the resource is Available and not Degraded. Progressing doesn't affect the root condition, since a
+// resource may be available while rolling out a change, but is unhappy while True. Additional terminal
+// subconditions may be provided.
+func OperatorStatusConditions(d ...string) ConditionTypes {
+	return NewReadyConditions(append([]string{ConditionAvailable, ConditionDegraded}, d...)...).
+		NegativePolarity(ConditionProgressing, ConditionDegraded)
+}
+
 func newConditionTypes(root string, dependents ...string) ConditionTypes {
 	return ConditionTypes{
 		root:       root,
@@ -42,6 +59,41 @@ func newConditionTypes(root string, dependents ...string) ConditionTypes {
 	}
 }
 
+// DependsOn declares that conditionType is only meaningful once its dependencies are True, e.g. a condition
+// that a resource is registered depends on the resource having launched. See ValidateDependencyGraph.
+func (r ConditionTypes) DependsOn(conditionType string, dependencies ...string) ConditionTypes {
+	// Copy to avoid mutating the dependencies shared with the ConditionTypes this was created from
+	r.dependencies = lo.Assign(r.dependencies, map[string][]string{
+		conditionType: lo.Uniq(append(slices.Clone(r.dependencies[conditionType]), dependencies...)),
+	})
+	return r
+}
+
+// NegativePolarity declares condition types for which True is the abnormal status, following the Kubernetes
+// convention for conditions such as Degraded or MemoryPressure. A negative polarity dependent that is True counts
+// against the root condition, while one that is False counts toward it.
+func (r ConditionTypes) NegativePolarity(conditionTypes ...string) ConditionTypes {
+	// Copy to avoid mutating the condition types shared with the ConditionTypes this was created from
+	r.negativePolarity = lo.Uniq(append(slices.Clone(r.negativePolarity), conditionTypes...))
+	return r
+}
+
+// isHealthy returns true if the condition has its normal status, which is True unless it has negative polarity
+func (r ConditionTypes) isHealthy(condition Condition) bool {
+	if lo.Contains(r.negativePolarity, condition.Type) {
+		return condition.IsFalse()
+	}
+	return condition.IsTrue()
+}
+
+// isAbnormal returns true if the condition has its abnormal status, which is False unless it has negative polarity
+func (r ConditionTypes) isAbnormal(condition Condition) bool {
+	if lo.Contains(r.negativePolarity, condition.Type) {
+		return condition.IsTrue()
+	}
+	return condition.IsFalse()
+}
+
 // ConditionSet provides methods for evaluating Conditions.
 // +k8s:deepcopy-gen=false
 type ConditionSet struct {
@@ -53,8 +105,9 @@ type ConditionSet struct {
 // ConditionTypes as a reference. Status must be a pointer to a struct.
 func (r ConditionTypes) For(object Object) ConditionSet {
 	cs := ConditionSet{object: object, ConditionTypes: r}
+	cs.dependents = cs.allDependents()
 	// Set known conditions Unknown if not set.
-	for _, t := range append(r.dependents, r.root) {
+	for _, t := range append(cs.dependents, r.root) {
 		if cs.Get(t) == nil {
 			cs.SetUnknown(t)
 		}
@@ -62,6 +115,77 @@ func (r ConditionTypes) For(object Object) ConditionSet {
 	return cs
 }
 
+// DeepCopy returns a copy of the ConditionSet, including a copy of the object holding its conditions
+func (c ConditionSet) DeepCopy() ConditionSet {
+	out := ConditionSet{}
+	c.DeepCopyInto(&out)
+	return out
+}
+
+// DeepCopyInto copies the ConditionSet into out, including a copy of the object holding its conditions
+func (in *ConditionSet) DeepCopyInto(out *ConditionSet) {
+	*out = *in
+	out.dependents = slices.Clone(in.dependents)
+	out.negativePolarity = slices.Clone(in.negativePolarity)
+	out.dependencies = lo.MapValues(in.dependencies, func(dependencies []string, _ string) []string { return slices.Clone(dependencies) })
+	if in.object != nil {
+		out.object = in.object.DeepCopyObject().(Object)
+	}
+}
+
+// WithTransform returns a view of the ConditionSet with each condition transformed, e.g. to normalize the reasons
+// written by various controllers for display or metric emission. The view is a copy, so the object holding the
+// conditions is unchanged, and changes to the view aren't persisted.
+func (c ConditionSet) WithTransform(transform func(Condition) Condition) ConditionSet {
+	view := c.DeepCopy()
+	if view.object != nil {
+		view.object.SetConditions(lo.Map(view.object.GetConditions(), func(condition Condition, _ int) Condition { return transform(condition) }))
+	}
+	return view
+}
+
+// RuntimeDependentsAnnotationKey is the annotation which holds the dependents of the root condition added at
+// runtime, see AddDependent
+const RuntimeDependentsAnnotationKey = "operatorpkg.k8s.aws/runtime-dependents"
+
+// AddDependent registers a dependent of the root condition at runtime, e.g. one dependent per discovered component,
+// and recomputes the root condition. The dependent is initialized to Unknown if not set. Like SetData, the dependent
+// is stored in an annotation on the object, so that every ConditionSet of the object sees it.
+// Returns false if rootType is not the root condition or dependentType is already a dependent.
+func (c ConditionSet) AddDependent(rootType, dependentType string) (modified bool) {
+	if c.object == nil || rootType != c.root || dependentType == c.root || lo.Contains(c.allDependents(), dependentType) {
+		return false
+	}
+	c.object.SetAnnotations(lo.Assign(c.object.GetAnnotations(), map[string]string{
+		RuntimeDependentsAnnotationKey: string(lo.Must(json.Marshal(append(c.runtimeDependents(), dependentType)))),
+	}))
+	if c.Get(dependentType) == nil {
+		c.SetUnknown(dependentType)
+	} else {
+		c.recomputeRootCondition(dependentType)
+	}
+	return true
+}
+
+// runtimeDependents returns the dependents of the root condition added at runtime, see AddDependent
+func (c ConditionSet) runtimeDependents() []string {
+	if c.object == nil {
+		return nil
+	}
+	var dependents []string
+	if value, ok := c.object.GetAnnotations()[RuntimeDependentsAnnotationKey]; ok {
+		if err := json.Unmarshal([]byte(value), &dependents); err != nil {
+			return nil
+		}
+	}
+	return dependents
+}
+
+// allDependents returns the dependents of the root condition, including those added at runtime
+func (c ConditionSet) allDependents() []string {
+	return lo.Reject(lo.Uniq(append(slices.Clone(c.dependents), c.runtimeDependents()...)), func(t string, _ int) bool { return t == c.root })
+}
+
 // Root returns the root Condition, typically "Ready" or "Succeeded"
 func (c ConditionSet) Root() *Condition {
 	if c.object == nil {
@@ -70,6 +194,28 @@ func (c ConditionSet) Root() *Condition {
 	return c.Get(c.root)
 }
 
+// RootReason explains why the root condition is not True. It returns the reason of the most significant
+// blocking dependent, preferring abnormal over Unknown and then the most recent transition, or if dependencies
+// are declared with DependsOn, the first in topological order. If no dependents are blocking, the root
+// condition's own reason is returned.
+func (c ConditionSet) RootReason() string {
+	root := c.Root()
+	if root == nil {
+		return ""
+	}
+	if root.IsTrue() {
+		return root.Reason
+	}
+	conditions := c.findUnhealthyDependents()
+	if len(conditions) == 0 {
+		return root.Reason
+	}
+	if condition, found := lo.Find(conditions, c.isAbnormal); found {
+		return condition.Reason
+	}
+	return conditions[0].Reason
+}
+
 func (c ConditionSet) List() []Condition {
 	if c.object == nil {
 		return nil
@@ -77,6 +223,29 @@ func (c ConditionSet) List() []Condition {
 	return c.object.GetConditions()
 }
 
+// GetObservedGeneration returns the observedGeneration of the condition. Returns false if the condition doesn't
+// exist or doesn't track the generation, i.e. the observedGeneration is unset.
+func (c ConditionSet) GetObservedGeneration(conditionType string) (int64, bool) {
+	condition := c.Get(conditionType)
+	if condition == nil || condition.ObservedGeneration == 0 {
+		return 0, false
+	}
+	return condition.ObservedGeneration, true
+}
+
+// CountByStatus returns the number of conditions in each status
+func (c ConditionSet) CountByStatus() map[metav1.ConditionStatus]int {
+	return lo.CountValuesBy(c.List(), func(condition Condition) metav1.ConditionStatus { return condition.Status })
+}
+
+// LastTransitionTime returns the most recent LastTransitionTime of the conditions, i.e. when any condition last
+// changed status, or the zero time if there are no conditions
+func (c ConditionSet) LastTransitionTime() metav1.Time {
+	return lo.Reduce(c.List(), func(latest metav1.Time, condition Condition, _ int) metav1.Time {
+		return lo.Ternary(condition.LastTransitionTime.After(latest.Time), condition.LastTransitionTime, latest)
+	}, metav1.Time{})
+}
+
 // GetCondition finds and returns the Condition that matches the ConditionType
 // previously set on Conditions.
 func (c ConditionSet) Get(t string) *Condition {
@@ -89,6 +258,49 @@ func (c ConditionSet) Get(t string) *Condition {
 	return nil
 }
 
+// ConditionDataAnnotationPrefix prefixes the annotations which hold the structured data of a condition, keyed by type
+const ConditionDataAnnotationPrefix = "condition-data.operatorpkg.k8s.aws/"
+
+// GetData returns the structured data attached to the condition, see SetData.
+func (c ConditionSet) GetData(conditionType string) (map[string]string, bool) {
+	if c.object == nil {
+		return nil, false
+	}
+	value, ok := c.object.GetAnnotations()[ConditionDataAnnotationPrefix+conditionType]
+	if !ok {
+		return nil, false
+	}
+	data := map[string]string{}
+	if err := json.Unmarshal([]byte(value), &data); err != nil {
+		return nil, false
+	}
+	return data, true
+}
+
+// SetData attaches structured data to the condition, beyond its reason and message. The data is stored in an
+// annotation on the object, since the condition API doesn't allow for extension. Empty data removes the annotation.
+func (c ConditionSet) SetData(conditionType string, data map[string]string) (modified bool) {
+	if c.object == nil {
+		return false
+	}
+	annotations := lo.Assign(c.object.GetAnnotations())
+	key := ConditionDataAnnotationPrefix + conditionType
+	if len(data) == 0 {
+		if _, ok := annotations[key]; !ok {
+			return false
+		}
+		delete(annotations, key)
+	} else {
+		value := string(lo.Must(json.Marshal(data)))
+		if annotations[key] == value {
+			return false
+		}
+		annotations[key] = value
+	}
+	c.object.SetAnnotations(annotations)
+	return true
+}
+
 // True returns true if all condition types are true.
 func (c ConditionSet) IsTrue(conditionTypes ...string) bool {
 	for _, conditionType := range conditionTypes {
@@ -99,6 +311,21 @@ func (c ConditionSet) IsTrue(conditionTypes ...string) bool {
 	return true
 }
 
+// IsHappy returns true if the root condition is True, e.g. to short circuit reconciliation of ready objects
+func (c ConditionSet) IsHappy() bool {
+	return c.Root().IsTrue()
+}
+
+// IsUnhappy returns true if the root condition isn't True
+func (c ConditionSet) IsUnhappy() bool {
+	return !c.IsHappy()
+}
+
+// UnhappyConditions returns the conditions that aren't True, or for negative polarity conditions, aren't False
+func (c ConditionSet) UnhappyConditions() []Condition {
+	return lo.Reject(c.List(), func(condition Condition, _ int) bool { return c.isHealthy(condition) })
+}
+
 // Set sets or updates the Condition on Conditions for Condition.Type.
 // If there is an update, Conditions are stored back sorted.
 func (c ConditionSet) Set(condition Condition) (modified bool) {
@@ -126,6 +353,27 @@ func (c ConditionSet) Set(condition Condition) (modified bool) {
 	return true
 }
 
+// Touch bumps the LastTransitionTime of the condition without changing its status, reason or message.
+// This signals liveness on a condition, and is not counted as a transition by the status controller.
+// Returns false if the condition does not exist.
+func (c ConditionSet) Touch(conditionType string) (modified bool) {
+	if c.object == nil {
+		return false
+	}
+	var conditions []Condition
+	for _, c := range c.object.GetConditions() {
+		if c.Type == conditionType {
+			c.LastTransitionTime = metav1.Now()
+			modified = true
+		}
+		conditions = append(conditions, c)
+	}
+	if modified {
+		c.object.SetConditions(conditions)
+	}
+	return modified
+}
+
 // RemoveCondition removes the non normal condition that matches the ConditionType
 // Not implemented for normal conditions
 func (c ConditionSet) Clear(t string) error {
@@ -135,7 +383,7 @@ func (c ConditionSet) Clear(t string) error {
 		return nil
 	}
 	// Normal conditions are not handled as they can't be nil
-	if t == c.root || lo.Contains(c.dependents, t) {
+	if t == c.root || lo.Contains(c.allDependents(), t) {
 		return fmt.Errorf("clearing normal conditions not implemented")
 	}
 	cond := c.Get(t)
@@ -155,43 +403,93 @@ func (c ConditionSet) Clear(t string) error {
 	return nil
 }
 
+// Prune removes any condition whose type isn't in the keep list, e.g. stale condition types left by older
+// controller versions, and returns the types that were removed. Normal conditions are always kept.
+func (c ConditionSet) Prune(keep ...string) (removed []string) {
+	if c.object == nil {
+		return nil
+	}
+	conditions := lo.Filter(c.object.GetConditions(), func(condition Condition, _ int) bool {
+		if condition.Type == c.root || lo.Contains(c.allDependents(), condition.Type) || lo.Contains(keep, condition.Type) {
+			return true
+		}
+		removed = append(removed, condition.Type)
+		return false
+	})
+	if len(removed) > 0 {
+		c.object.SetConditions(conditions)
+	}
+	return removed
+}
+
 // SetTrue sets the status of t to true with the reason, and then marks the root condition to
 // true if all other dependents are also true.
 func (c ConditionSet) SetTrue(conditionType string) (modified bool) {
-	return c.SetTrueWithReason(conditionType, conditionType, "")
+	return c.SetTrueWithReason(conditionType, ConditionReason(conditionType), "")
 }
 
 // SetTrueWithReason sets the status of t to true with the reason, and then marks the root condition to
 // true if all other dependents are also true.
-func (c ConditionSet) SetTrueWithReason(conditionType string, reason, message string) (modified bool) {
-	return c.Set(Condition{
-		Type:    conditionType,
-		Status:  metav1.ConditionTrue,
-		Reason:  reason,
-		Message: message,
-	})
+func (c ConditionSet) SetTrueWithReason(conditionType string, reason ConditionReason, message string) (modified bool) {
+	return c.Set(newCondition(conditionType, metav1.ConditionTrue, reason, message))
 }
 
 // SetUnknown sets the status of conditionType to Unknown and also sets the root condition
 // to Unknown if no other dependent condition is in an error state.
 func (r ConditionSet) SetUnknown(conditionType string) (modified bool) {
 	// set the specified condition
-	return r.Set(Condition{
-		Type:    conditionType,
-		Status:  metav1.ConditionUnknown,
-		Reason:  "AwaitingReconciliation",
-		Message: "object is awaiting reconciliation",
-	})
+	return r.Set(newCondition(conditionType, metav1.ConditionUnknown, ConditionReasonAwaitingReconciliation, "object is awaiting reconciliation"))
+}
+
+// SetUnknownWithReason sets the status of conditionType to Unknown with the reason, e.g. when a dependency
+// becomes unreachable, and also sets the root condition to Unknown if no other dependent condition is in an
+// error state. Unlike SetUnknown, the LastTransitionTime is only updated if the status changes.
+func (r ConditionSet) SetUnknownWithReason(conditionType string, reason ConditionReason, message string) (modified bool) {
+	return r.SetStatusCondition(metav1.Condition(newCondition(conditionType, metav1.ConditionUnknown, reason, message)))
 }
 
 // SetFalse sets the status of t and the root condition to False.
-func (r ConditionSet) SetFalse(conditionType string, reason, message string) (modified bool) {
-	return r.Set(Condition{
+func (r ConditionSet) SetFalse(conditionType string, reason ConditionReason, message string) (modified bool) {
+	return r.Set(newCondition(conditionType, metav1.ConditionFalse, reason, message))
+}
+
+// SetFalseTemplate sets the status of t and the root condition to False, with the message rendered from a
+// text/template against obj, e.g.
+//
+//	conditions.SetFalseTemplate(ConditionTypeReplicasReady, "ReplicasNotReady", "{{.Spec.Replicas}} replicas not ready", o)
+//
+// The condition is left unmodified if the template fails to parse or render.
+func (r ConditionSet) SetFalseTemplate(conditionType string, reason ConditionReason, tmpl string, obj any) (modified bool, err error) {
+	t, err := template.New(conditionType).Option("missingkey=error").Parse(tmpl)
+	if err != nil {
+		return false, fmt.Errorf("parsing message template, %w", err)
+	}
+	message := &strings.Builder{}
+	if err := t.Execute(message, obj); err != nil {
+		return false, fmt.Errorf("rendering message template, %w", err)
+	}
+	return r.SetFalse(conditionType, reason, message.String()), nil
+}
+
+// SetFromError sets the status of conditionType to true if err is nil, and otherwise to false with the
+// reason and the error as the message, e.g.
+//
+//	conditions.SetFromError(ConditionTypeLaunched, "LaunchFailed", c.launch(ctx, o))
+func (c ConditionSet) SetFromError(conditionType string, reason ConditionReason, err error) (modified bool) {
+	if err == nil {
+		return c.SetTrue(conditionType)
+	}
+	return c.SetFalse(conditionType, reason, err.Error())
+}
+
+// newCondition returns a condition of the type with the status, reason and message
+func newCondition(conditionType string, status metav1.ConditionStatus, reason ConditionReason, message string) Condition {
+	return Condition{
 		Type:    conditionType,
-		Status:  metav1.ConditionFalse,
-		Reason:  reason,
+		Status:  status,
+		Reason:  string(reason),
 		Message: message,
-	})
+	}
 }
 
 // recomputeRootCondition marks the root condition to true if all other dependents are also true.
@@ -202,38 +500,46 @@ func (r ConditionSet) recomputeRootCondition(conditionType string) {
 	if conditions := r.findUnhealthyDependents(); len(conditions) == 0 {
 		r.SetTrue(r.root)
 	} else {
-		r.Set(Condition{
-			Type: r.root,
+		r.Set(newCondition(
+			r.root,
 			// The root condition is no longer unknown as soon as any are false
-			Status: lo.Ternary(
-				lo.ContainsBy(conditions, func(condition Condition) bool { return condition.IsFalse() }),
+			lo.Ternary(
+				lo.ContainsBy(conditions, r.isAbnormal),
 				metav1.ConditionFalse,
 				metav1.ConditionUnknown,
 			),
-			Reason: "UnhealthyDependents",
-			Message: strings.Join(lo.Map(conditions, func(condition Condition, _ int) string {
+			ConditionReasonUnhealthyDependents,
+			strings.Join(lo.Map(conditions, func(condition Condition, _ int) string {
 				return fmt.Sprintf("%s=%s", condition.Type, condition.Status)
 			}), ", "),
-		})
+		))
 	}
 }
 
 func (c ConditionSet) findUnhealthyDependents() []Condition {
-	if len(c.dependents) == 0 {
+	dependents := c.allDependents()
+	if len(dependents) == 0 {
 		return nil
 	}
 	// Get dependent conditions
 	conditions := c.object.GetConditions()
 	conditions = lo.Filter(conditions, func(condition Condition, _ int) bool {
-		return lo.Contains(c.dependents, condition.Type)
+		return lo.Contains(dependents, condition.Type)
 	})
-	conditions = lo.Filter(conditions, func(condition Condition, _ int) bool {
-		return condition.IsFalse() || condition.IsUnknown()
+	conditions = lo.Reject(conditions, func(condition Condition, _ int) bool {
+		return c.isHealthy(condition)
 	})
 
 	// Sort set conditions by time.
 	sort.Slice(conditions, func(i, j int) bool {
 		return conditions[i].LastTransitionTime.After(conditions[j].LastTransitionTime.Time)
 	})
+	// Unmet dependencies precede the conditions that depend on them, so that the first condition explains the others
+	if len(c.dependencies) > 0 {
+		order := c.topologicalOrder()
+		sort.SliceStable(conditions, func(i, j int) bool {
+			return lo.IndexOf(order, conditions[i].Type) < lo.IndexOf(order, conditions[j].Type)
+		})
+	}
 	return conditions
 }
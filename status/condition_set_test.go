@@ -1,11 +1,20 @@
 package status_test
 
 import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
 	"time"
 
+	"github.com/awslabs/operatorpkg/status"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"github.com/samber/lo"
 
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -59,6 +68,503 @@ var _ = Describe("Conditions", func() {
 		Expect(conditions.SetFalse(ConditionTypeBar, "another-reason", "another-message")).To(BeFalse())
 	})
 
+	It("should touch a condition without changing its status", func() {
+		testObject := TestObject{}
+		conditions := testObject.StatusConditions()
+		Expect(conditions.SetFalse(ConditionTypeFoo, "reason", "message")).To(BeTrue())
+		fooCondition := conditions.Get(ConditionTypeFoo)
+		time.Sleep(1 * time.Nanosecond)
+
+		Expect(conditions.Touch(ConditionTypeFoo)).To(BeTrue())
+		touchedCondition := conditions.Get(ConditionTypeFoo)
+		Expect(touchedCondition.Status).To(Equal(metav1.ConditionFalse))
+		Expect(touchedCondition.Reason).To(Equal("reason"))
+		Expect(touchedCondition.Message).To(Equal("message"))
+		Expect(touchedCondition.LastTransitionTime.UnixNano()).To(BeNumerically(">", fooCondition.LastTransitionTime.UnixNano()))
+		Expect(conditions.Root().GetStatus()).To(Equal(metav1.ConditionFalse))
+		// Conditions that don't exist are not touched
+		Expect(conditions.Touch(ConditionTypeBaz)).To(BeFalse())
+		Expect(conditions.Get(ConditionTypeBaz)).To(BeNil())
+	})
+
+	It("should recompute the root condition when a dependent is added at runtime", func() {
+		testObject := TestObject{}
+		conditions := testObject.StatusConditions()
+		conditions.SetTrue(ConditionTypeFoo)
+		conditions.SetTrue(ConditionTypeBar)
+		Expect(conditions.Root().GetStatus()).To(Equal(metav1.ConditionTrue))
+
+		Expect(conditions.AddDependent(status.ConditionReady, ConditionTypeBaz)).To(BeTrue())
+		Expect(conditions.Get(ConditionTypeBaz).GetStatus()).To(Equal(metav1.ConditionUnknown))
+		Expect(conditions.Root().GetStatus()).To(Equal(metav1.ConditionUnknown))
+		Expect(conditions.SetFalse(ConditionTypeBaz, "reason", "message")).To(BeTrue())
+		Expect(conditions.Root().GetStatus()).To(Equal(metav1.ConditionFalse))
+		Expect(conditions.SetTrue(ConditionTypeBaz)).To(BeTrue())
+		Expect(conditions.Root().GetStatus()).To(Equal(metav1.ConditionTrue))
+
+		// Adding an existing dependent or a dependent of an unknown root is a no-op
+		Expect(conditions.AddDependent(status.ConditionReady, ConditionTypeFoo)).To(BeFalse())
+		Expect(conditions.AddDependent(status.ConditionSucceeded, "Qux")).To(BeFalse())
+		Expect(conditions.Get("Qux")).To(BeNil())
+	})
+	It("should keep a dependent added at runtime across condition sets of the object", func() {
+		testObject := TestObject{}
+		testObject.StatusConditions().SetTrue(ConditionTypeFoo)
+		testObject.StatusConditions().SetTrue(ConditionTypeBar)
+		Expect(testObject.StatusConditions().AddDependent(status.ConditionReady, ConditionTypeBaz)).To(BeTrue())
+		Expect(testObject.StatusConditions().Root().GetStatus()).To(Equal(metav1.ConditionUnknown))
+
+		// Conditions set through another condition set still roll up into the root condition
+		testObject.StatusConditions().SetFalse(ConditionTypeBaz, "reason", "message")
+		Expect(testObject.StatusConditions().Root().GetStatus()).To(Equal(metav1.ConditionFalse))
+		testObject.StatusConditions().SetTrue(ConditionTypeBaz)
+		Expect(testObject.StatusConditions().Root().GetStatus()).To(Equal(metav1.ConditionTrue))
+		Expect(testObject.StatusConditions().AddDependent(status.ConditionReady, ConditionTypeBaz)).To(BeFalse())
+		Expect(testObject.StatusConditions().Clear(ConditionTypeBaz)).ToNot(Succeed())
+
+		// Copies of the object keep the dependent
+		copied := testObject.DeepCopy()
+		copied.StatusConditions().SetFalse(ConditionTypeBaz, "reason", "message")
+		Expect(copied.StatusConditions().Root().GetStatus()).To(Equal(metav1.ConditionFalse))
+	})
+	It("should recompute the root condition when an existing condition is added as a dependent", func() {
+		testObject := TestObject{}
+		conditions := testObject.StatusConditions()
+		conditions.SetTrue(ConditionTypeFoo)
+		conditions.SetTrue(ConditionTypeBar)
+		conditions.SetFalse(ConditionTypeBaz, "reason", "message")
+		Expect(conditions.Root().GetStatus()).To(Equal(metav1.ConditionTrue))
+
+		Expect(conditions.AddDependent(status.ConditionReady, ConditionTypeBaz)).To(BeTrue())
+		Expect(conditions.Root().GetStatus()).To(Equal(metav1.ConditionFalse))
+	})
+	It("should prune conditions not in the keep list", func() {
+		testObject := TestObject{}
+		conditions := testObject.StatusConditions()
+		conditions.SetTrue(ConditionTypeFoo)
+		conditions.SetTrue(ConditionTypeBaz)
+		conditions.SetFalse("Stale", "reason", "message")
+		conditions.SetFalse("AnotherStale", "reason", "message")
+
+		Expect(conditions.Prune(ConditionTypeBaz)).To(ConsistOf("Stale", "AnotherStale"))
+		Expect(lo.Map(conditions.List(), func(c status.Condition, _ int) string { return c.Type })).To(ConsistOf(
+			status.ConditionReady, ConditionTypeFoo, ConditionTypeBar, ConditionTypeBaz,
+		))
+		Expect(conditions.Get(ConditionTypeFoo).IsTrue()).To(BeTrue())
+		Expect(conditions.Prune(ConditionTypeBaz)).To(BeEmpty())
+		Expect(conditions.Prune()).To(ConsistOf(ConditionTypeBaz))
+	})
+	It("should round trip structured data for a condition", func() {
+		testObject := TestObject{}
+		conditions := testObject.StatusConditions()
+		_, ok := conditions.GetData(ConditionTypeFoo)
+		Expect(ok).To(BeFalse())
+
+		Expect(conditions.SetData(ConditionTypeFoo, map[string]string{"replicas": "3", "zone": "us-west-2a"})).To(BeTrue())
+		Expect(conditions.SetData(ConditionTypeFoo, map[string]string{"replicas": "3", "zone": "us-west-2a"})).To(BeFalse())
+		data, ok := conditions.GetData(ConditionTypeFoo)
+		Expect(ok).To(BeTrue())
+		Expect(data).To(Equal(map[string]string{"replicas": "3", "zone": "us-west-2a"}))
+		_, ok = conditions.GetData(ConditionTypeBar)
+		Expect(ok).To(BeFalse())
+
+		Expect(conditions.SetData(ConditionTypeFoo, nil)).To(BeTrue())
+		Expect(conditions.SetData(ConditionTypeFoo, nil)).To(BeFalse())
+		_, ok = conditions.GetData(ConditionTypeFoo)
+		Expect(ok).To(BeFalse())
+		Expect(testObject.Annotations).To(BeEmpty())
+	})
+	It("should marshal a stable form matching the golden fixture", func() {
+		testObject := TestObject{}
+		conditions := testObject.StatusConditions()
+		conditions.Set(status.Condition{Type: ConditionTypeBaz, Status: metav1.ConditionTrue, Reason: ConditionTypeBaz, ObservedGeneration: 2})
+		conditions.SetTrue(ConditionTypeFoo)
+		conditions.SetFalse(ConditionTypeBar, "reason", "message")
+
+		raw, err := conditions.MarshalJSONWithOptions(status.MarshalOptions{OmitVolatileFields: true})
+		Expect(err).ToNot(HaveOccurred())
+		indented := &bytes.Buffer{}
+		Expect(json.Indent(indented, raw, "", "  ")).To(Succeed())
+		indented.WriteString("\n")
+		Expect(indented.String()).To(Equal(string(lo.Must(os.ReadFile("testdata/conditions.golden.json")))))
+
+		// Volatile fields are included by default
+		raw, err = json.Marshal(conditions)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(raw)).To(ContainSubstring("lastTransitionTime"))
+	})
+	It("should round trip the conditions through their stable form", func() {
+		testObject := TestObject{}
+		conditions := testObject.StatusConditions()
+		conditions.SetTrue(ConditionTypeFoo)
+		conditions.SetFalse(ConditionTypeBar, "reason", "message")
+		raw, err := json.Marshal(conditions)
+		Expect(err).ToNot(HaveOccurred())
+
+		restoredObject := TestObject{}
+		restored := restoredObject.StatusConditions()
+		Expect(json.Unmarshal(raw, &restored)).To(Succeed())
+		Expect(restored.List()).To(HaveLen(len(conditions.List())))
+		for i, condition := range conditions.List() {
+			Expect(restored.List()[i]).To(And(
+				HaveField("Type", condition.Type),
+				HaveField("Status", condition.Status),
+				HaveField("Reason", condition.Reason),
+				HaveField("Message", condition.Message),
+				HaveField("LastTransitionTime.Time", BeTemporally("~", condition.LastTransitionTime.Time, time.Second)),
+			))
+		}
+		Expect(lo.Must(json.Marshal(restored))).To(MatchJSON(raw))
+	})
+	It("should not unmarshal conditions without an object", func() {
+		conditions := status.ConditionSet{}
+		Expect(json.Unmarshal([]byte("[]"), &conditions)).ToNot(Succeed())
+	})
+	It("should count conditions by status", func() {
+		testObject := TestObject{}
+		Expect(status.ConditionSet{}.CountByStatus()).To(BeEmpty())
+		conditions := testObject.StatusConditions()
+		Expect(conditions.CountByStatus()).To(Equal(map[metav1.ConditionStatus]int{metav1.ConditionUnknown: 3}))
+		conditions.SetTrue(ConditionTypeFoo)
+		conditions.SetFalse(ConditionTypeBar, "reason", "message")
+		conditions.SetTrue(ConditionTypeBaz)
+		Expect(conditions.CountByStatus()).To(Equal(map[metav1.ConditionStatus]int{
+			metav1.ConditionTrue:  2,
+			metav1.ConditionFalse: 2,
+		}))
+	})
+	It("should return the most recent transition time", func() {
+		Expect(status.ConditionSet{}.LastTransitionTime()).To(Equal(metav1.Time{}))
+		latest := metav1.NewTime(time.Now().Add(time.Hour).Truncate(time.Second))
+		testObject := TestObject{}
+		testObject.SetConditions([]status.Condition{
+			{Type: ConditionTypeFoo, Status: metav1.ConditionTrue, LastTransitionTime: metav1.NewTime(latest.Add(-time.Hour))},
+			{Type: ConditionTypeBar, Status: metav1.ConditionTrue, LastTransitionTime: latest},
+			{Type: status.ConditionReady, Status: metav1.ConditionTrue, LastTransitionTime: metav1.NewTime(latest.Add(-time.Minute))},
+		})
+		Expect(testObject.StatusConditions().LastTransitionTime()).To(Equal(latest))
+	})
+	Context("ConditionReason", func() {
+		const ConditionReasonLaunchFailed status.ConditionReason = "LaunchFailed"
+
+		It("should set typed reasons", func() {
+			testObject := TestObject{}
+			testObject.StatusConditions().SetFalse(ConditionTypeFoo, ConditionReasonLaunchFailed, "message")
+			Expect(testObject.StatusConditions().Get(ConditionTypeFoo).Reason).To(Equal(string(ConditionReasonLaunchFailed)))
+			Expect(testObject.StatusConditions().Root().Reason).To(Equal(string(status.ConditionReasonUnhealthyDependents)))
+			Expect(testObject.StatusConditions().Get(ConditionTypeBar).Reason).To(Equal(string(status.ConditionReasonAwaitingReconciliation)))
+
+			testObject.StatusConditions().SetTrueWithReason(ConditionTypeFoo, "Launched", "")
+			Expect(testObject.StatusConditions().Get(ConditionTypeFoo).Reason).To(Equal("Launched"))
+			testObject.StatusConditions().SetFromError(ConditionTypeFoo, ConditionReasonLaunchFailed, errors.New("error"))
+			Expect(testObject.StatusConditions().Get(ConditionTypeFoo).Reason).To(Equal(string(ConditionReasonLaunchFailed)))
+		})
+		It("should accept untyped string reasons", func() {
+			testObject := TestObject{}
+			testObject.StatusConditions().SetFalse(ConditionTypeFoo, "LaunchFailed", "message")
+			Expect(testObject.StatusConditions().Get(ConditionTypeFoo).Reason).To(Equal(string(ConditionReasonLaunchFailed)))
+
+			// Untyped string constants are accepted without conversion
+			const reason = "Launched"
+			testObject.StatusConditions().SetTrueWithReason(ConditionTypeFoo, reason, "")
+			Expect(testObject.StatusConditions().Get(ConditionTypeFoo).Reason).To(Equal(reason))
+			testObject.StatusConditions().SetFromError(ConditionTypeFoo, reason, errors.New("error"))
+			Expect(testObject.StatusConditions().Get(ConditionTypeFoo).Reason).To(Equal(reason))
+		})
+	})
+	Context("SetFalseTemplate", func() {
+		It("should render the message from the object", func() {
+			testObject := TestObject{Spec: TestSpec{Replicas: 3}}
+			modified, err := testObject.StatusConditions().SetFalseTemplate(ConditionTypeFoo, "ReplicasNotReady", "{{.Spec.Replicas}} replicas not ready", testObject)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(modified).To(BeTrue())
+			Expect(testObject.StatusConditions().Get(ConditionTypeFoo)).To(And(
+				HaveField("Status", metav1.ConditionFalse),
+				HaveField("Reason", "ReplicasNotReady"),
+				HaveField("Message", "3 replicas not ready"),
+			))
+		})
+		It("should not modify the condition if the template fails to render", func() {
+			testObject := TestObject{}
+			modified, err := testObject.StatusConditions().SetFalseTemplate(ConditionTypeFoo, "ReplicasNotReady", "{{.Spec.Missing}} replicas not ready", testObject)
+			Expect(err).To(HaveOccurred())
+			Expect(modified).To(BeFalse())
+			Expect(testObject.StatusConditions().Get(ConditionTypeFoo).IsUnknown()).To(BeTrue())
+		})
+	})
+	Context("SetFromError", func() {
+		It("should set the condition true without an error", func() {
+			testObject := TestObject{}
+			testObject.StatusConditions().SetFalse(ConditionTypeFoo, "reason", "message")
+			Expect(testObject.StatusConditions().SetFromError(ConditionTypeFoo, "reason", nil)).To(BeTrue())
+			Expect(testObject.StatusConditions().Get(ConditionTypeFoo)).To(And(
+				HaveField("Status", metav1.ConditionTrue),
+				HaveField("Reason", ConditionTypeFoo),
+				HaveField("Message", ""),
+			))
+		})
+		It("should set the condition false with the error as the message", func() {
+			testObject := TestObject{}
+			err := fmt.Errorf("launching instance, %w", errors.New("insufficient capacity"))
+			Expect(testObject.StatusConditions().SetFromError(ConditionTypeFoo, "LaunchFailed", err)).To(BeTrue())
+			Expect(testObject.StatusConditions().Get(ConditionTypeFoo)).To(And(
+				HaveField("Status", metav1.ConditionFalse),
+				HaveField("Reason", "LaunchFailed"),
+				HaveField("Message", "launching instance, insufficient capacity"),
+			))
+			Expect(testObject.StatusConditions().SetFromError(ConditionTypeFoo, "LaunchFailed", err)).To(BeFalse())
+		})
+	})
+	Context("SetUnknownWithReason", func() {
+		It("should set the condition unknown with the reason and recompute the root condition", func() {
+			testObject := TestObject{}
+			testObject.StatusConditions().SetTrue(ConditionTypeFoo)
+			testObject.StatusConditions().SetTrue(ConditionTypeBar)
+			Expect(testObject.StatusConditions().SetUnknownWithReason(ConditionTypeFoo, "DependencyUnreachable", "message")).To(BeTrue())
+			Expect(testObject.StatusConditions().Get(ConditionTypeFoo)).To(And(
+				HaveField("Status", metav1.ConditionUnknown),
+				HaveField("Reason", "DependencyUnreachable"),
+				HaveField("Message", "message"),
+			))
+			Expect(testObject.StatusConditions().Root().IsUnknown()).To(BeTrue())
+		})
+		It("should only update the LastTransitionTime when the status changes", func() {
+			testObject := TestObject{}
+			testObject.StatusConditions().SetUnknownWithReason(ConditionTypeFoo, "DependencyUnreachable", "message")
+			transitionTime := metav1.NewTime(time.Now().Add(-time.Hour).Truncate(time.Second))
+			testObject.Status.Conditions = lo.Map(testObject.Status.Conditions, func(condition status.Condition, _ int) status.Condition {
+				condition.LastTransitionTime = transitionTime
+				return condition
+			})
+			Expect(testObject.StatusConditions().SetUnknownWithReason(ConditionTypeFoo, "DependencyTimeout", "message")).To(BeTrue())
+			Expect(testObject.StatusConditions().Get(ConditionTypeFoo).LastTransitionTime).To(Equal(transitionTime))
+			testObject.StatusConditions().SetTrue(ConditionTypeFoo)
+			Expect(testObject.StatusConditions().Get(ConditionTypeFoo).LastTransitionTime).ToNot(Equal(transitionTime))
+		})
+	})
+	Context("SetStatusCondition", func() {
+		var testObject TestObject
+		var conditions []metav1.Condition
+		BeforeEach(func() {
+			testObject = TestObject{}
+			conditions = testObject.StatusConditions().MetaConditions()
+		})
+		// expectParity sets the condition on both the ConditionSet and the upstream slice, expecting identical results
+		expectParity := func(condition metav1.Condition) bool {
+			modified := testObject.StatusConditions().SetStatusCondition(condition)
+			Expect(meta.SetStatusCondition(&conditions, condition)).To(Equal(modified))
+			actual := meta.FindStatusCondition(testObject.StatusConditions().MetaConditions(), condition.Type)
+			expected := meta.FindStatusCondition(conditions, condition.Type)
+			Expect(actual).To(And(
+				HaveField("Status", expected.Status),
+				HaveField("Reason", expected.Reason),
+				HaveField("Message", expected.Message),
+				HaveField("ObservedGeneration", expected.ObservedGeneration),
+				HaveField("LastTransitionTime.Time", BeTemporally("~", expected.LastTransitionTime.Time, time.Second)),
+			))
+			return modified
+		}
+
+		It("should set a new condition", func() {
+			Expect(expectParity(metav1.Condition{Type: ConditionTypeBaz, Status: metav1.ConditionFalse, Reason: "reason", Message: "message"})).To(BeTrue())
+		})
+		It("should preserve the LastTransitionTime when the status doesn't change", func() {
+			Expect(expectParity(metav1.Condition{Type: ConditionTypeBaz, Status: metav1.ConditionFalse, Reason: "reason", LastTransitionTime: metav1.NewTime(time.Now().Add(-time.Hour))})).To(BeTrue())
+			Expect(expectParity(metav1.Condition{Type: ConditionTypeBaz, Status: metav1.ConditionFalse, Reason: "other", ObservedGeneration: 2})).To(BeTrue())
+			Expect(testObject.StatusConditions().Get(ConditionTypeBaz).LastTransitionTime.Time).To(BeTemporally("~", time.Now().Add(-time.Hour), time.Second))
+		})
+		It("should update the LastTransitionTime when the status changes", func() {
+			Expect(expectParity(metav1.Condition{Type: ConditionTypeBaz, Status: metav1.ConditionFalse, Reason: "reason", LastTransitionTime: metav1.NewTime(time.Now().Add(-time.Hour))})).To(BeTrue())
+			Expect(expectParity(metav1.Condition{Type: ConditionTypeBaz, Status: metav1.ConditionTrue, Reason: "reason"})).To(BeTrue())
+			Expect(testObject.StatusConditions().Get(ConditionTypeBaz).LastTransitionTime.Time).To(BeTemporally("~", time.Now(), time.Second))
+		})
+		It("should not modify an identical condition", func() {
+			Expect(expectParity(metav1.Condition{Type: ConditionTypeBaz, Status: metav1.ConditionFalse, Reason: "reason"})).To(BeTrue())
+			Expect(expectParity(metav1.Condition{Type: ConditionTypeBaz, Status: metav1.ConditionFalse, Reason: "reason"})).To(BeFalse())
+		})
+		It("should recompute the root condition", func() {
+			Expect(testObject.StatusConditions().SetStatusCondition(metav1.Condition{Type: ConditionTypeFoo, Status: metav1.ConditionFalse, Reason: "reason"})).To(BeTrue())
+			Expect(testObject.StatusConditions().Root().GetStatus()).To(Equal(metav1.ConditionFalse))
+		})
+	})
+	Context("GetObservedGeneration", func() {
+		It("should return the observedGeneration of a condition", func() {
+			testObject := TestObject{}
+			testObject.StatusConditions().Set(status.Condition{Type: ConditionTypeFoo, Status: metav1.ConditionTrue, Reason: "reason", ObservedGeneration: 3})
+			generation, ok := testObject.StatusConditions().GetObservedGeneration(ConditionTypeFoo)
+			Expect(ok).To(BeTrue())
+			Expect(generation).To(BeEquivalentTo(3))
+		})
+		It("should return false if the condition is absent", func() {
+			testObject := TestObject{}
+			_, ok := testObject.StatusConditions().GetObservedGeneration(ConditionTypeBaz)
+			Expect(ok).To(BeFalse())
+		})
+		It("should return false if the condition doesn't track the generation", func() {
+			testObject := TestObject{}
+			testObject.StatusConditions().SetTrue(ConditionTypeFoo)
+			generation, ok := testObject.StatusConditions().GetObservedGeneration(ConditionTypeFoo)
+			Expect(ok).To(BeFalse())
+			Expect(generation).To(BeZero())
+		})
+	})
+	It("should deep copy a condition set", func() {
+		testObject := &TestObject{}
+		conditions := testObject.StatusConditions()
+		conditions.SetTrue(ConditionTypeFoo)
+
+		copied := conditions.DeepCopy()
+		Expect(copied.List()).To(Equal(conditions.List()))
+		copied.SetFalse(ConditionTypeFoo, "reason", "message")
+		copied.AddDependent(status.ConditionReady, ConditionTypeBaz)
+		Expect(conditions.Get(ConditionTypeFoo).IsTrue()).To(BeTrue())
+		Expect(conditions.Root().IsUnknown()).To(BeTrue())
+		Expect(conditions.Get(ConditionTypeBaz)).To(BeNil())
+		Expect(copied.Get(ConditionTypeFoo).IsFalse()).To(BeTrue())
+		Expect(copied.Root().IsFalse()).To(BeTrue())
+
+		into := status.ConditionSet{}
+		conditions.DeepCopyInto(&into)
+		into.Set(status.Condition{Type: ConditionTypeFoo, Status: metav1.ConditionFalse, Reason: "reason"})
+		Expect(conditions.Get(ConditionTypeFoo).IsTrue()).To(BeTrue())
+		Expect(status.ConditionSet{}.DeepCopy().List()).To(BeNil())
+	})
+	It("should transform a view of the condition set", func() {
+		testObject := &TestObject{}
+		conditions := testObject.StatusConditions()
+		conditions.SetFalse(ConditionTypeFoo, "launch_failed", "message")
+
+		view := conditions.WithTransform(func(condition status.Condition) status.Condition {
+			condition.Reason = strings.ToUpper(condition.Reason)
+			return condition
+		})
+		Expect(view.Get(ConditionTypeFoo)).To(And(HaveField("Status", metav1.ConditionFalse), HaveField("Reason", "LAUNCH_FAILED")))
+		Expect(view.Root().Reason).To(Equal("UNHEALTHYDEPENDENTS"))
+		Expect(conditions.Get(ConditionTypeFoo).Reason).To(Equal("launch_failed"))
+		Expect(testObject.StatusConditions().Get(ConditionTypeFoo).Reason).To(Equal("launch_failed"))
+	})
+	Context("RootReason", func() {
+		It("should return the root reason when the root is true", func() {
+			testObject := TestObject{}
+			testObject.StatusConditions().SetTrue(ConditionTypeFoo)
+			testObject.StatusConditions().SetTrue(ConditionTypeBar)
+			Expect(testObject.StatusConditions().RootReason()).To(Equal(status.ConditionReady))
+		})
+		It("should return the reason of an unknown dependent", func() {
+			testObject := TestObject{}
+			testObject.StatusConditions().SetTrue(ConditionTypeFoo)
+			Expect(testObject.StatusConditions().RootReason()).To(Equal("AwaitingReconciliation"))
+		})
+		It("should prefer a false dependent over an unknown dependent", func() {
+			testObject := TestObject{}
+			testObject.StatusConditions().SetFalse(ConditionTypeFoo, "FooFailed", "message")
+			time.Sleep(1 * time.Nanosecond)
+			testObject.StatusConditions().SetUnknown(ConditionTypeBar)
+			Expect(testObject.StatusConditions().RootReason()).To(Equal("FooFailed"))
+		})
+		It("should prefer the most recent false dependent", func() {
+			testObject := TestObject{}
+			testObject.StatusConditions().SetFalse(ConditionTypeFoo, "FooFailed", "message")
+			time.Sleep(1 * time.Nanosecond)
+			testObject.StatusConditions().SetFalse(ConditionTypeBar, "BarFailed", "message")
+			Expect(testObject.StatusConditions().RootReason()).To(Equal("BarFailed"))
+		})
+		It("should prefer the earliest unmet dependency in topological order", func() {
+			conditions := status.NewReadyConditions(ConditionTypeBar, ConditionTypeFoo).DependsOn(ConditionTypeBar, ConditionTypeFoo).For(&TestObject{})
+			conditions.SetFalse(ConditionTypeFoo, "FooFailed", "message")
+			time.Sleep(1 * time.Nanosecond)
+			conditions.SetFalse(ConditionTypeBar, "BarFailed", "message")
+			Expect(conditions.RootReason()).To(Equal("FooFailed"))
+			Expect(conditions.Root().Message).To(Equal("Foo=False, Bar=False"))
+		})
+		It("should return the root reason when the root is set directly", func() {
+			testObject := TestObject{}
+			testObject.StatusConditions().SetTrue(ConditionTypeFoo)
+			testObject.StatusConditions().SetTrue(ConditionTypeBar)
+			testObject.StatusConditions().SetFalse(status.ConditionReady, "RootFailed", "message")
+			Expect(testObject.StatusConditions().RootReason()).To(Equal("RootFailed"))
+		})
+	})
+
+	It("should be happy only when the root condition is true", func() {
+		testObject := TestObject{}
+		Expect(testObject.StatusConditions().IsHappy()).To(BeFalse())
+		Expect(testObject.StatusConditions().IsUnhappy()).To(BeTrue())
+		Expect(testObject.StatusConditions().UnhappyConditions()).To(ConsistOf(
+			HaveField("Type", status.ConditionReady),
+			HaveField("Type", ConditionTypeFoo),
+			HaveField("Type", ConditionTypeBar),
+		))
+
+		testObject.StatusConditions().SetTrue(ConditionTypeFoo)
+		Expect(testObject.StatusConditions().IsHappy()).To(BeFalse())
+		Expect(testObject.StatusConditions().UnhappyConditions()).To(ConsistOf(
+			HaveField("Type", status.ConditionReady),
+			HaveField("Type", ConditionTypeBar),
+		))
+
+		testObject.StatusConditions().SetTrue(ConditionTypeBar)
+		Expect(testObject.StatusConditions().IsHappy()).To(BeTrue())
+		Expect(testObject.StatusConditions().IsUnhappy()).To(BeFalse())
+		Expect(testObject.StatusConditions().UnhappyConditions()).To(BeEmpty())
+	})
+
+	It("should treat True as abnormal for negative polarity conditions", func() {
+		testObject := &TestObject{}
+		conditions := status.NewReadyConditions(ConditionTypeFoo, ConditionTypeBaz).NegativePolarity(ConditionTypeBaz).For(testObject)
+		conditions.SetTrue(ConditionTypeFoo)
+		Expect(conditions.Root().IsUnknown()).To(BeTrue())
+
+		conditions.SetFalse(ConditionTypeBaz, "Healthy", "")
+		Expect(conditions.IsHappy()).To(BeTrue())
+		Expect(conditions.UnhappyConditions()).To(BeEmpty())
+
+		conditions.SetTrueWithReason(ConditionTypeBaz, "Overloaded", "")
+		Expect(conditions.Root().IsFalse()).To(BeTrue())
+		Expect(conditions.RootReason()).To(Equal("Overloaded"))
+		Expect(conditions.UnhappyConditions()).To(ConsistOf(
+			HaveField("Type", status.ConditionReady),
+			HaveField("Type", ConditionTypeBaz),
+		))
+	})
+
+	Context("OperatorStatusConditions", func() {
+		var conditions status.ConditionSet
+		BeforeEach(func() {
+			conditions = status.OperatorStatusConditions().For(&TestObject{})
+		})
+		It("should be healthy once available and not degraded", func() {
+			Expect(conditions.Root().IsUnknown()).To(BeTrue())
+			conditions.SetTrue(status.ConditionAvailable)
+			conditions.SetFalse(status.ConditionDegraded, "AsExpected", "")
+			Expect(conditions.IsHappy()).To(BeTrue())
+			Expect(conditions.UnhappyConditions()).To(BeEmpty())
+		})
+		It("should remain healthy while progressing", func() {
+			conditions.SetTrue(status.ConditionAvailable)
+			conditions.SetFalse(status.ConditionDegraded, "AsExpected", "")
+			conditions.SetTrueWithReason(status.ConditionProgressing, "RollingOut", "")
+			Expect(conditions.IsHappy()).To(BeTrue())
+			Expect(conditions.UnhappyConditions()).To(ConsistOf(HaveField("Type", status.ConditionProgressing)))
+		})
+		It("should be unhealthy while degraded", func() {
+			conditions.SetTrue(status.ConditionAvailable)
+			conditions.SetTrueWithReason(status.ConditionDegraded, "ReplicasUnavailable", "")
+			Expect(conditions.Root().IsFalse()).To(BeTrue())
+			Expect(conditions.RootReason()).To(Equal("ReplicasUnavailable"))
+			Expect(conditions.UnhappyConditions()).To(ConsistOf(
+				HaveField("Type", status.ConditionReady),
+				HaveField("Type", status.ConditionDegraded),
+			))
+		})
+		It("should be unhealthy while unavailable", func() {
+			conditions.SetFalse(status.ConditionAvailable, "NoReplicas", "")
+			conditions.SetFalse(status.ConditionDegraded, "AsExpected", "")
+			Expect(conditions.Root().IsFalse()).To(BeTrue())
+			Expect(conditions.RootReason()).To(Equal("NoReplicas"))
+		})
+	})
+
 	It("all true", func() {
 		testObject := TestObject{}
 		Expect(testObject.StatusConditions().IsTrue()).To(BeTrue())
@@ -3,90 +3,665 @@ package status
 import (
 	"context"
 	"fmt"
+	"maps"
+	"sort"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/awslabs/operatorpkg/object"
 	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
 	"github.com/samber/lo"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/clock"
 	controllerruntime "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/metrics"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 )
 
-const (
-	MetricLabelGroup           = "group"
-	MetricLabelKind            = "kind"
-	MetricLabelNamespace       = "namespace"
-	MetricLabelName            = "name"
-	MetricLabelConditionType   = "type"
-	MetricLabelConditionStatus = "status"
-)
+// ControllerOpts configures the behavior of the status controller
+type ControllerOpts struct {
+	// ReadOnly guarantees that the controller never writes to the API server, so that it can be used with
+	// a client that only has read permission. Features that would persist state to the API server are disabled.
+	ReadOnly bool
+	// Clock is used to measure time, and defaults to the real clock. Tests may inject a fake clock.
+	Clock clock.Clock
+	// AnnotationLabels maps annotation keys to metric label names, which are added to the condition metrics
+	// with the value of the annotation on the object, e.g. {"example.com/team": "team"}. Objects without the
+	// annotation are labeled with an empty value. Each distinct annotation value multiplies the cardinality
+	// of the condition metrics, so only use annotations with a small, bounded set of values.
+	AnnotationLabels map[string]string
+	// ContextLabels returns labels that are evaluated on each reconcile and added to the condition metrics, e.g. the
+	// identity of the leader or the shard reconciling the object. Only the labels named by ContextLabelNames are added,
+	// and missing labels are added with an empty value.
+	ContextLabels func(ctx context.Context) map[string]string
+	// ContextLabelNames are the names of the labels returned by ContextLabels
+	ContextLabelNames []string
+	// External labels the condition metrics with external="true", marking conditions of a kind that's owned by
+	// another operator, e.g. a kind observed with NewControllerWithAccessors for cross-operator observability
+	External bool
+	// Checkpoint persists the observed condition statuses and transition times to annotations on the object, so that
+	// transitions are reported from the correct status, and measured, across controller restarts. Ignored in ReadOnly mode.
+	Checkpoint bool
+	// TerminationOwnerLabel labels termination metrics with the controlling owner of the object, e.g. the NodePool
+	// that owns a Node, for fleet views of termination duration.
+	TerminationOwnerLabel bool
+	// TerminationPropagationLabel labels termination metrics with how deletion of the object was requested, i.e.
+	// Forced for a zero grace period, or otherwise the propagation policy of Orphan, Foreground or Background,
+	// so that forced and orphaning deletions can be distinguished from normal deletions.
+	TerminationPropagationLabel bool
+	// TerminationReasonAnnotation labels termination metrics with the value of the annotation on the object when
+	// it's deleted, e.g. to separate graceful from forced teardowns. Objects without the annotation are labeled with
+	// an empty reason.
+	TerminationReasonAnnotation string
+	// OwnerReadyRatio emits the fraction of the objects of each controlling owner whose root condition is True, e.g.
+	// the fraction of the Nodes of a NodePool that are Ready, computed at scrape time from the conditions last observed
+	// by the controller
+	OwnerReadyRatio bool
+	// SpecStatusFields are numeric fields compared between the spec and status of the object, emitted as the
+	// difference between desired and observed state, e.g. desired replicas minus ready replicas.
+	SpecStatusFields []SpecStatusField
+	// EventSinks receive each observed transition, in addition to the Kubernetes event recorder. To send
+	// transitions only to the sinks, pass a nil event recorder to NewController. Sinks that implement
+	// manager.Runnable are started by Register.
+	EventSinks []EventSink
+	// TransitionHysteresis is the minimum duration a condition must hold a new status before the transition is
+	// recorded, so that conditions flapping around a threshold don't emit a transition for each flip.
+	TransitionHysteresis time.Duration
+	// Namespace restricts the controller to objects in the namespace, and defaults to all namespaces
+	Namespace string
+	// Selector restricts the controller to objects whose labels match the selector, and defaults to all objects
+	Selector labels.Selector
+	// PauseAnnotation is the annotation with which objects are paused, and defaults to operator.sh/paused. The
+	// metrics of objects annotated with "true" are cleared, and no transitions are recorded until they're unpaused.
+	PauseAnnotation string
+	// SkipPredicates skip reconciliation of matching objects, e.g. paused objects, which is recorded by reason
+	SkipPredicates []SkipPredicate
+	// ChangePredicate returns whether an update to an object is a meaningful change that should be reconciled, e.g.
+	// a change to the conditions or to a specific annotation, and defaults to reconciling every update. Objects are
+	// still periodically reconciled at the RequeueInterval.
+	ChangePredicate func(old, new client.Object) bool
+	// RateLimiter limits how frequently objects are requeued, and defaults to the controller-runtime default
+	RateLimiter workqueue.RateLimiter
+	// MaxConcurrentReconciles is the number of objects reconciled concurrently, and defaults to 10
+	MaxConcurrentReconciles int
+	// MetricTTL garbage collects the series of objects that haven't been reconciled within the duration, e.g. if
+	// the informer dropped an object without a delete event. It must exceed the RequeueInterval.
+	MetricTTL time.Duration
+	// MinObjectAgeForMetrics suppresses ConditionCurrentStatusSeconds for objects younger than the duration, since
+	// freshly created objects are expected to be briefly unready, which would otherwise be alerted on
+	MinObjectAgeForMetrics time.Duration
+	// ConditionCountValue returns the value of ConditionCount for a condition of the object, e.g. the number of
+	// replicas affected by the condition, and defaults to 1
+	ConditionCountValue func(o client.Object, condition Condition) float64
+	// KnownConditionTypes are the condition types of the kind, in addition to the root condition and its dependents.
+	// Conditions of other types, e.g. retired by a controller upgrade, are ignored, so that their metrics are cleaned up.
+	KnownConditionTypes []string
+	// PruneUnknownConditions removes conditions whose types aren't known from the status of the object, see
+	// KnownConditionTypes. Ignored in ReadOnly mode.
+	PruneUnknownConditions bool
+	// ConditionFieldManager ignores conditions that aren't owned by the field manager according to the managedFields
+	// of the object, so that conditions set by other controllers don't emit metrics or transitions
+	ConditionFieldManager string
+	// TransitionSeverity classifies each transition, and defaults to SeverityInfo. Transitions of Warning severity
+	// or above are emitted as Warning events.
+	TransitionSeverity func(previous, current Condition) Severity
+	// EventMessageFunc formats the message of the event recorded for a transition of a condition of the object, e.g.
+	// for localization, and defaults to "Status condition transitioned, Type: Ready, Status: False -> True, ..."
+	EventMessageFunc func(o client.Object, previous, current Condition) string
+	// EventThrottle is the minimum interval between events for transitions below Warning severity of an object.
+	// Transitions of Warning severity or above are never throttled. Metrics are recorded regardless.
+	EventThrottle time.Duration
+	// UnknownGrace is the duration a condition may be Unknown, e.g. transiently during a reconcile, before it's
+	// counted as Unknown by ObjectsByCondition. Conditions that are Unknown within the grace are counted as Pending.
+	UnknownGrace time.Duration
+	// LazyCurrentStatusSeconds computes ConditionCurrentStatusSeconds at scrape time from the conditions last
+	// observed by the controller, rather than setting each series on every reconcile, which reduces series churn
+	// for large numbers of objects
+	LazyCurrentStatusSeconds bool
+	// IsLeader returns whether this replica should emit metrics and events, e.g. the leader of an HA deployment
+	// in which the controller runs on every replica. Replicas that aren't the leader forget the objects they
+	// reconcile, so that their series aren't double counted, and observe them afresh once they become the leader.
+	IsLeader func() bool
+	// RequeueInterval is the interval at which objects are periodically reconciled, which bounds the freshness of
+	// ConditionCurrentStatusSeconds, and defaults to 10 seconds. Negative intervals are invalid.
+	RequeueInterval time.Duration
+	// MetricNamespace replaces the "operator" prefix of the metric names, e.g. "karpenter" emits
+	// karpenter_status_condition_count, so that operator frameworks sharing a binary can be distinguished
+	MetricNamespace string
+	// MetricSubsystem separates the metrics of this controller from those of controllers for other kinds, e.g.
+	// "nodepool" emits operator_nodepool_status_condition_count
+	MetricSubsystem string
+	// TracerProvider provides the tracer with which each reconcile is wrapped in a span named
+	// operatorpkg.<kind>.status.reconcile, and defaults to a no-op provider
+	TracerProvider trace.TracerProvider
+	// ReducedCardinality omits the per-object namespace and name labels from ConditionCount and
+	// ConditionCurrentStatusSeconds, which are instead aggregated across objects at scrape time. Counts are summed,
+	// and current status seconds is the longest that any object has held the status. Enable for large fleets of
+	// objects, or many short lived objects, whose per-object series would destabilize the TSDB.
+	ReducedCardinality bool
+}
+
+// PauseAnnotation is the default annotation with which objects are paused, see ControllerOpts.PauseAnnotation
+const PauseAnnotation = "operator.sh/paused"
 
 const (
-	MetricNamespace = "operator"
-	MetricSubsystem = "status_condition"
+	SkipReasonPaused    = "paused"
+	SkipReasonExcluded  = "excluded"
+	SkipReasonNamespace = "namespace"
 )
 
-type Controller[T Object] struct {
-	kubeClient         client.Client
-	eventRecorder      record.EventRecorder
+// SkipPredicate skips reconciliation of objects for which Skip returns true, e.g.
+//
+//	SkipPredicate{Reason: SkipReasonPaused, Skip: func(o client.Object) bool { return o.GetAnnotations()["example.com/paused"] == "true" }}
+type SkipPredicate struct {
+	Reason string
+	Skip   func(client.Object) bool
+}
+
+// SpecStatusField identifies a numeric field in the spec and the corresponding field in the status by their paths, e.g.
+//
+//	SpecStatusField{Name: "replicas", SpecPath: []string{"spec", "replicas"}, StatusPath: []string{"status", "readyReplicas"}}
+type SpecStatusField struct {
+	Name       string
+	SpecPath   []string
+	StatusPath []string
+}
+
+// ConditionAccessors get and set the conditions of an object whose type doesn't implement Object, e.g. a CRD
+// whose Go type can't be modified, with conditions in a non-standard status struct
+type ConditionAccessors[T client.Object] struct {
+	GetConditions func(T) []Condition
+	SetConditions func(T, []Condition)
+}
+
+type Controller[T client.Object] struct {
+	kubeClient    client.Client
+	eventRecorder record.EventRecorder
+	// mu guards the state of observed objects, which is shared with the metric sweeper and collectors. Objects are
+	// reconciled concurrently, so mu is only held while the state is read or written, never during API or sink calls.
+	mu                 sync.Mutex
 	observedConditions map[reconcile.Request]ConditionSet
+	lastReconciled     map[reconcile.Request]time.Time
+	terminatingObjects map[reconcile.Request]T
+	lastInfoEvent      map[reconcile.Request]time.Time
+	// countedStatuses are the statuses with which the conditions of each object are counted by ObjectsByCondition
+	countedStatuses map[reconcile.Request]map[string]string
+	// contextLabels are the labels with which the metrics of each object were last emitted, see ControllerOpts.ContextLabels
+	contextLabels map[reconcile.Request]prometheus.Labels
+	accessors     ConditionAccessors[T]
+	// unregisterCollectors stop the collectors that read the state of observed objects from reading it, see Unregister
+	unregisterCollectors []func()
+	// resolveConditions returns the object holding the conditions of the object, if not the object itself
+	resolveConditions func(context.Context, T) (Object, error)
+	opts              ControllerOpts
+	metrics           controllerMetrics
 }
 
-func NewController[T Object](client client.Client, eventRecorder record.EventRecorder) *Controller[T] {
-	return &Controller[T]{
+func NewController[T Object](client client.Client, eventRecorder record.EventRecorder, opts ...ControllerOpts) *Controller[T] {
+	return NewControllerWithAccessors(client, eventRecorder, ConditionAccessors[T]{
+		GetConditions: func(o T) []Condition { return o.GetConditions() },
+		SetConditions: func(o T, conditions []Condition) { o.SetConditions(conditions) },
+	}, opts...)
+}
+
+// NewControllerWithAccessors constructs a controller for a type that doesn't implement Object, using the accessors
+// to get and set its conditions
+func NewControllerWithAccessors[T client.Object](client client.Client, eventRecorder record.EventRecorder, accessors ConditionAccessors[T], opts ...ControllerOpts) *Controller[T] {
+	c := &Controller[T]{
 		kubeClient:         client,
 		eventRecorder:      eventRecorder,
+		accessors:          accessors,
 		observedConditions: map[reconcile.Request]ConditionSet{},
+		lastReconciled:     map[reconcile.Request]time.Time{},
+		terminatingObjects: map[reconcile.Request]T{},
+		lastInfoEvent:      map[reconcile.Request]time.Time{},
+		countedStatuses:    map[reconcile.Request]map[string]string{},
+		contextLabels:      map[reconcile.Request]prometheus.Labels{},
+	}
+	if len(opts) > 0 {
+		c.opts = opts[0]
 	}
+	if c.opts.RequeueInterval < 0 {
+		panic(fmt.Sprintf("invalid RequeueInterval %s, must not be negative", c.opts.RequeueInterval))
+	}
+	if c.opts.RequeueInterval == 0 {
+		c.opts.RequeueInterval = time.Second * 10
+	}
+	if c.opts.Clock == nil {
+		c.opts.Clock = clock.RealClock{}
+	}
+	if c.opts.TransitionSeverity == nil {
+		c.opts.TransitionSeverity = transitionSeverity
+	}
+	if c.opts.EventMessageFunc == nil {
+		c.opts.EventMessageFunc = eventMessage
+	}
+	if c.opts.ConditionCountValue == nil {
+		c.opts.ConditionCountValue = conditionCountValue
+	}
+	if c.opts.MetricNamespace == "" {
+		c.opts.MetricNamespace = MetricNamespace
+	}
+	if c.opts.PauseAnnotation == "" {
+		c.opts.PauseAnnotation = PauseAnnotation
+	}
+	if c.opts.TracerProvider == nil {
+		c.opts.TracerProvider = noop.NewTracerProvider()
+	}
+	c.metrics = newControllerMetrics(c.metricNamespace(), c.labelNames()...)
+	if terminationLabels := lo.Compact([]string{
+		lo.Ternary(c.opts.TerminationOwnerLabel, MetricLabelOwner, ""),
+		lo.Ternary(c.opts.TerminationPropagationLabel, MetricLabelPropagation, ""),
+		lo.Ternary(c.opts.TerminationReasonAnnotation != "", MetricLabelTerminationReason, ""),
+	}); len(terminationLabels) > 0 {
+		c.metrics.TerminationDuration = register(terminationDurationMetric(c.metricNamespace(), terminationLabels...))
+	}
+	if c.opts.ReducedCardinality {
+		sharedCollector("reduced_cardinality", c, newReducedCardinalityCollector[T])
+	} else if c.opts.LazyCurrentStatusSeconds {
+		sharedCollector("current_status_seconds", c, newCurrentStatusSecondsCollector[T])
+	}
+	if c.opts.OwnerReadyRatio {
+		sharedCollector("owner_ready_ratio", c, newOwnerReadyRatioCollector[T])
+	}
+	return c
 }
 
 func (c *Controller[T]) Register(ctx context.Context, m manager.Manager) error {
+	if c.opts.MetricTTL > 0 {
+		if err := m.Add(manager.RunnableFunc(c.sweep)); err != nil {
+			return fmt.Errorf("adding metric sweeper, %w", err)
+		}
+	}
+	// Sinks that deliver in the background, e.g. WebhookSink, are started with the manager
+	for _, sink := range c.opts.EventSinks {
+		if runnable, ok := sink.(manager.Runnable); ok {
+			if err := m.Add(runnable); err != nil {
+				return fmt.Errorf("adding event sink, %w", err)
+			}
+		}
+	}
+	if len(c.unregisterCollectors) > 0 {
+		if err := m.Add(manager.RunnableFunc(func(ctx context.Context) error {
+			<-ctx.Done()
+			c.Unregister()
+			return nil
+		})); err != nil {
+			return fmt.Errorf("adding collector cleanup, %w", err)
+		}
+	}
 	return controllerruntime.NewControllerManagedBy(m).
 		For(object.New[T]()).
 		Named("status").
+		WithOptions(c.controllerOptions()).
+		WithEventFilter(c.eventFilter()).
 		Complete(c)
 }
 
+// Unregister stops the metrics computed at scrape time, e.g. OwnerReadyRatio, from reading the state of the
+// controller, once it's no longer running. Controllers registered with a manager are unregistered when it stops.
+func (c *Controller[T]) Unregister() {
+	for _, unregister := range c.unregisterCollectors {
+		unregister()
+	}
+	c.unregisterCollectors = nil
+}
+
+// eventFilter returns the predicate used to filter the events of the controller, see ControllerOpts.Namespace,
+// ControllerOpts.Selector and ControllerOpts.ChangePredicate
+func (c *Controller[T]) eventFilter() predicate.Predicate {
+	predicates := []predicate.Predicate{predicate.NewPredicateFuncs(func(o client.Object) bool {
+		_, skipped := c.scopeSkipReason(o)
+		return !skipped
+	})}
+	if c.opts.ChangePredicate != nil {
+		predicates = append(predicates, predicate.Funcs{
+			UpdateFunc: func(e event.UpdateEvent) bool { return c.opts.ChangePredicate(e.ObjectOld, e.ObjectNew) },
+		})
+	}
+	return predicate.And(predicates...)
+}
+
+// scopeSkipReason returns the reason that the object is outside the scope of the controller, if it is, see
+// ControllerOpts.Namespace and ControllerOpts.Selector
+func (c *Controller[T]) scopeSkipReason(o client.Object) (string, bool) {
+	if c.opts.Namespace != "" && o.GetNamespace() != c.opts.Namespace {
+		return SkipReasonNamespace, true
+	}
+	if c.opts.Selector != nil && !c.opts.Selector.Matches(labels.Set(o.GetLabels())) {
+		return SkipReasonExcluded, true
+	}
+	return "", false
+}
+
+// controllerOptions returns the options used to register the controller
+func (c *Controller[T]) controllerOptions() controller.Options {
+	return controller.Options{
+		RateLimiter:             c.opts.RateLimiter,
+		MaxConcurrentReconciles: lo.Ternary(c.opts.MaxConcurrentReconciles > 0, c.opts.MaxConcurrentReconciles, 10),
+	}
+}
+
 func (c *Controller[T]) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
 	o := object.New[T]()
 	gvk := object.GVK(o)
+	transitions := 0
+	ctx, span := c.opts.TracerProvider.Tracer(TracerName).Start(ctx, fmt.Sprintf("operatorpkg.%s.status.reconcile", object.KindString(o)), trace.WithAttributes(
+		attribute.String(SpanAttributeNamespace, req.Namespace),
+		attribute.String(SpanAttributeName, req.Name),
+	))
+	defer func() {
+		span.SetAttributes(attribute.Int(SpanAttributeTransitions, transitions))
+		span.End()
+	}()
+	activeReconciles := c.metrics.ActiveReconciles.With(prometheus.Labels{
+		MetricLabelGroup: gvk.Group,
+		MetricLabelKind:  gvk.Kind,
+	})
+	activeReconciles.Inc()
+	defer activeReconciles.Dec()
+
+	if c.opts.IsLeader != nil && !c.opts.IsLeader() {
+		c.forget(gvk, req)
+		return reconcile.Result{RequeueAfter: c.opts.RequeueInterval}, nil
+	}
+
+	// Detect and record the time since this object was last reconciled, which helps to detect informer starvation
+	now := c.opts.Clock.Now()
+	c.mu.Lock()
+	lastReconciled, reconciled := c.lastReconciled[req]
+	c.lastReconciled[req] = now
+	c.mu.Unlock()
+	if reconciled {
+		c.metrics.ReconcileGap.With(prometheus.Labels{
+			MetricLabelGroup: gvk.Group,
+			MetricLabelKind:  gvk.Kind,
+		}).Observe(now.Sub(lastReconciled).Seconds())
+	}
 
 	if err := c.kubeClient.Get(ctx, req.NamespacedName, o); err != nil {
 		if errors.IsNotFound(err) {
-			ConditionCount.DeletePartialMatch(prometheus.Labels{
-				MetricLabelGroup:     gvk.Group,
-				MetricLabelKind:      gvk.Kind,
-				MetricLabelNamespace: string(req.Namespace),
-				MetricLabelName:      string(req.Name),
-			})
+			c.mu.Lock()
+			defer c.mu.Unlock()
+			if terminatingObject, ok := c.terminatingObjects[req]; ok {
+				c.metrics.TerminationDuration.With(c.terminationLabels(terminatingObject)).Observe(now.Sub(terminatingObject.GetDeletionTimestamp().Time).Seconds())
+			}
+			// Conditions restored from a checkpoint don't know when they transitioned, so their age is unknown
+			if observedConditions, ok := c.observedConditions[req]; ok {
+				for _, condition := range observedConditions.List() {
+					if condition.LastTransitionTime.IsZero() {
+						continue
+					}
+					c.metrics.ConditionAgeAtDeletion.With(lo.Assign(prometheus.Labels{
+						MetricLabelGroup:           gvk.Group,
+						MetricLabelKind:            gvk.Kind,
+						MetricLabelVersion:         gvk.Version,
+						MetricLabelConditionType:   string(condition.Type),
+						MetricLabelConditionStatus: string(condition.Status),
+					}, c.metricLabels(req, observedConditions.object))).Observe(max(now.Sub(condition.LastTransitionTime.Time).Seconds(), 0))
+				}
+			}
+			c.forgetLocked(gvk, req)
 			return reconcile.Result{}, nil
 		}
 		return reconcile.Result{}, fmt.Errorf("getting object, %w", err)
 	}
+	// Objects that left the scope of the controller, e.g. by relabeling, may still be requeued, so are forgotten here
+	// rather than only filtered from the watch
+	if reason, skipped := c.scopeSkipReason(o); skipped {
+		c.forget(gvk, req)
+		c.metrics.ReconcilesSkipped.With(prometheus.Labels{
+			MetricLabelGroup:      gvk.Group,
+			MetricLabelKind:       gvk.Kind,
+			MetricLabelSkipReason: reason,
+		}).Inc()
+		return reconcile.Result{}, nil
+	}
+	// Paused objects are forgotten, so that their metrics are cleared and they're observed afresh once unpaused
+	if o.GetAnnotations()[c.opts.PauseAnnotation] == "true" {
+		c.forget(gvk, req)
+		c.metrics.ReconcilesSkipped.With(prometheus.Labels{
+			MetricLabelGroup:      gvk.Group,
+			MetricLabelKind:       gvk.Kind,
+			MetricLabelSkipReason: SkipReasonPaused,
+		}).Inc()
+		return reconcile.Result{}, nil
+	}
+	if predicate, found := lo.Find(c.opts.SkipPredicates, func(predicate SkipPredicate) bool { return predicate.Skip(o) }); found {
+		c.metrics.ReconcilesSkipped.With(prometheus.Labels{
+			MetricLabelGroup:      gvk.Group,
+			MetricLabelKind:       gvk.Kind,
+			MetricLabelSkipReason: predicate.Reason,
+		}).Inc()
+		return reconcile.Result{}, nil
+	}
+	// Remember terminating objects, so that termination duration can be measured once they're gone
+	if object.IsBeingDeleted(o) {
+		c.mu.Lock()
+		c.terminatingObjects[req] = o
+		c.mu.Unlock()
+	}
+
+	c.mu.Lock()
+	observedConditions, ok := c.observedConditions[req]
+	c.mu.Unlock()
+	// Objects observed without a prior observation, e.g. after a restart, have no previous statuses to transition from
+	if !ok {
+		c.metrics.ColdObservations.With(prometheus.Labels{
+			MetricLabelGroup: gvk.Group,
+			MetricLabelKind:  gvk.Kind,
+		}).Inc()
+	}
+	if !ok && c.opts.Checkpoint {
+		observedConditions = c.restoreCheckpoint(o)
+	}
+	so, err := c.conditionsObject(ctx, o)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	// Conditions read from another object, e.g. a stale copy, would emit transitions that never happened
+	if mismatched, ok := c.mismatchedObject(o, so); ok {
+		log.FromContext(ctx).Info("skipping conditions read from a different object than the one reconciled", "uid", o.GetUID(), "resourceVersion", o.GetResourceVersion(), "conditionsUID", mismatched.GetUID(), "conditionsResourceVersion", mismatched.GetResourceVersion())
+		c.metrics.AdapterMismatches.With(prometheus.Labels{
+			MetricLabelGroup: gvk.Group,
+			MetricLabelKind:  gvk.Kind,
+		}).Inc()
+		return reconcile.Result{RequeueAfter: c.opts.RequeueInterval}, nil
+	}
+	if len(c.opts.KnownConditionTypes) > 0 {
+		removed, err := c.pruneUnknownConditions(ctx, o, so)
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+		// Series may have been emitted before the types were retired, e.g. by a previous configuration
+		for _, conditionType := range removed {
+			c.forgetConditionType(gvk, req, conditionType)
+		}
+	}
+	// Conditions written by other controllers may omit LastTransitionTime. We stamp these in memory
+	// when first observed, so that durations aren't computed relative to the zero time.
+	so.SetConditions(lo.Map(so.GetConditions(), func(condition Condition, _ int) Condition {
+		if condition.LastTransitionTime.IsZero() {
+			if observedCondition := observedConditions.Get(condition.Type); observedCondition != nil && observedCondition.Status == condition.Status {
+				condition.LastTransitionTime = observedCondition.LastTransitionTime
+			} else {
+				condition.LastTransitionTime = metav1.NewTime(now)
+			}
+		}
+		return condition
+	}))
+	currentConditions := so.StatusConditions()
+	// Filtered after StatusConditions, which may initialize conditions that aren't owned by the field manager
+	if c.opts.ConditionFieldManager != "" {
+		if managed, all := managedConditionTypes(o, c.opts.ConditionFieldManager); !all {
+			so.SetConditions(lo.Filter(so.GetConditions(), func(condition Condition, _ int) bool { return lo.Contains(managed, condition.Type) }))
+		}
+	}
+	// Transitions that haven't held for the hysteresis are pending, so the previously observed condition is
+	// retained until the new status has held long enough to be recorded
+	requeueAfter := c.opts.RequeueInterval
+	storedConditions := currentConditions
+	if c.opts.TransitionHysteresis > 0 && observedConditions.object != nil {
+		stored := so.DeepCopyObject().(Object)
+		stored.SetConditions(lo.Map(so.GetConditions(), func(condition Condition, _ int) Condition {
+			observedCondition := observedConditions.Get(condition.Type)
+			if observedCondition == nil || observedCondition.Status == condition.Status {
+				return condition
+			}
+			if held := c.opts.Clock.Since(condition.LastTransitionTime.Time); held < c.opts.TransitionHysteresis {
+				requeueAfter = min(requeueAfter, c.opts.TransitionHysteresis-held)
+				return *observedCondition
+			}
+			return condition
+		}))
+		storedConditions = ConditionSet{object: stored, ConditionTypes: currentConditions.ConditionTypes}
+	}
+	// Conditions that have been Unknown for less than the grace are pending, and are recounted once the grace passes
+	countedStatuses := lo.SliceToMap(storedConditions.List(), func(condition Condition) (string, string) {
+		if condition.IsUnknown() && c.opts.UnknownGrace > 0 {
+			if unknown := c.opts.Clock.Since(condition.LastTransitionTime.Time); unknown < c.opts.UnknownGrace {
+				requeueAfter = min(requeueAfter, c.opts.UnknownGrace-unknown)
+				return condition.Type, MetricConditionStatusPending
+			}
+		}
+		return condition.Type, string(condition.Status)
+	})
+	contextLabels := c.contextLabelValues(ctx)
+	objectLabels := lo.Assign(c.annotationLabels(o), contextLabels)
 
-	currentConditions := o.StatusConditions()
-	observedConditions := c.observedConditions[req]
-	c.observedConditions[req] = currentConditions
+	c.mu.Lock()
+	c.observedConditions[req] = storedConditions
+	// Conditions restored from a checkpoint were never counted by this controller
+	if counted, ok := c.countedStatuses[req]; ok {
+		c.countObjectsByCondition(gvk, counted, -1)
+	}
+	c.countObjectsByCondition(gvk, countedStatuses, 1)
+	c.countedStatuses[req] = countedStatuses
+	relabeled := observedConditions.object != nil && !maps.Equal(objectLabels, c.metricLabels(req, observedConditions.object))
+	c.contextLabels[req] = contextLabels
+	c.mu.Unlock()
+
+	// If the annotations or context used as metric labels have changed, clear the series with the previous labels
+	if relabeled {
+		c.metrics.ConditionCount.DeletePartialMatch(prometheus.Labels{
+			MetricLabelGroup:     gvk.Group,
+			MetricLabelKind:      gvk.Kind,
+			MetricLabelNamespace: string(req.Namespace),
+			MetricLabelName:      string(req.Name),
+		})
+		c.metrics.ConditionCurrentStatusSeconds.DeletePartialMatch(prometheus.Labels{
+			MetricLabelGroup:     gvk.Group,
+			MetricLabelKind:      gvk.Kind,
+			MetricLabelNamespace: string(req.Namespace),
+			MetricLabelName:      string(req.Name),
+		})
+		c.metrics.ConditionTotalSeconds.DeletePartialMatch(prometheus.Labels{
+			MetricLabelGroup:     gvk.Group,
+			MetricLabelKind:      gvk.Kind,
+			MetricLabelNamespace: string(req.Namespace),
+			MetricLabelName:      string(req.Name),
+		})
+	}
 
 	// Detect and record condition counts
-	for _, condition := range o.GetConditions() {
-		ConditionCount.With(prometheus.Labels{
-			MetricLabelGroup:           gvk.Group,
-			MetricLabelKind:            gvk.Kind,
-			MetricLabelNamespace:       string(req.Namespace),
-			MetricLabelName:            string(req.Name),
-			MetricLabelConditionType:   string(condition.Type),
-			MetricLabelConditionStatus: string(condition.Status),
-		}).Set(1)
+	for _, condition := range so.GetConditions() {
+		if !c.opts.ReducedCardinality {
+			c.metrics.ConditionCount.With(lo.Assign(prometheus.Labels{
+				MetricLabelGroup:           gvk.Group,
+				MetricLabelKind:            gvk.Kind,
+				MetricLabelVersion:         gvk.Version,
+				MetricLabelNamespace:       string(req.Namespace),
+				MetricLabelName:            string(req.Name),
+				MetricLabelConditionType:   string(condition.Type),
+				MetricLabelConditionStatus: string(condition.Status),
+			}, objectLabels)).Set(c.opts.ConditionCountValue(o, condition))
+		}
+		if !c.opts.LazyCurrentStatusSeconds && !c.opts.ReducedCardinality && c.opts.Clock.Since(o.GetCreationTimestamp().Time) >= c.opts.MinObjectAgeForMetrics {
+			c.metrics.ConditionCurrentStatusSeconds.With(lo.Assign(prometheus.Labels{
+				MetricLabelGroup:           gvk.Group,
+				MetricLabelKind:            gvk.Kind,
+				MetricLabelVersion:         gvk.Version,
+				MetricLabelNamespace:       string(req.Namespace),
+				MetricLabelName:            string(req.Name),
+				MetricLabelConditionType:   string(condition.Type),
+				MetricLabelConditionStatus: string(condition.Status),
+			}, objectLabels)).Set(c.opts.Clock.Since(condition.LastTransitionTime.Time).Seconds())
+		}
+		// Conditions without an observedGeneration don't track the generation, so can't be stale
+		staleLabels := prometheus.Labels{
+			MetricLabelGroup:         gvk.Group,
+			MetricLabelKind:          gvk.Kind,
+			MetricLabelNamespace:     string(req.Namespace),
+			MetricLabelName:          string(req.Name),
+			MetricLabelConditionType: string(condition.Type),
+		}
+		if condition.ObservedGeneration != 0 && condition.ObservedGeneration < o.GetGeneration() {
+			c.metrics.ConditionStale.With(staleLabels).Set(1)
+		} else {
+			c.metrics.ConditionStale.Delete(staleLabels)
+		}
+		if condition.ObservedGeneration != 0 {
+			c.metrics.ConditionObservedGenerationLag.With(staleLabels).Set(float64(o.GetGeneration() - condition.ObservedGeneration))
+		} else {
+			c.metrics.ConditionObservedGenerationLag.Delete(staleLabels)
+		}
+	}
+	// Accumulate the time since the last reconcile into the total seconds of each status, split at transitions.
+	// Conditions observed for the first time are accumulated from their last transition. Conditions whose status
+	// hasn't changed held it since the last reconcile, even if their LastTransitionTime moved forward, e.g. by Touch.
+	for _, condition := range storedConditions.List() {
+		totalSecondsLabels := func(conditionStatus metav1.ConditionStatus) prometheus.Labels {
+			return lo.Assign(prometheus.Labels{
+				MetricLabelGroup:           gvk.Group,
+				MetricLabelKind:            gvk.Kind,
+				MetricLabelVersion:         gvk.Version,
+				MetricLabelNamespace:       string(req.Namespace),
+				MetricLabelName:            string(req.Name),
+				MetricLabelConditionType:   string(condition.Type),
+				MetricLabelConditionStatus: string(conditionStatus),
+			}, objectLabels)
+		}
+		since := condition.LastTransitionTime.Time
+		if observedCondition := observedConditions.Get(condition.Type); reconciled && observedCondition != nil {
+			if observedCondition.Status == condition.Status || since.Before(lastReconciled) {
+				since = lastReconciled
+			} else {
+				c.metrics.ConditionTotalSeconds.With(totalSecondsLabels(observedCondition.Status)).Add(since.Sub(lastReconciled).Seconds())
+			}
+		}
+		c.metrics.ConditionTotalSeconds.With(totalSecondsLabels(condition.Status)).Add(max(now.Sub(since).Seconds(), 0))
 	}
 	for _, observedCondition := range observedConditions.List() {
 		if currentCondition := currentConditions.Get(observedCondition.Type); currentCondition == nil || currentCondition.Status != observedCondition.Status {
-			ConditionCount.Delete(prometheus.Labels{
+			c.metrics.ConditionCount.DeletePartialMatch(prometheus.Labels{
+				MetricLabelGroup:           gvk.Group,
+				MetricLabelKind:            gvk.Kind,
+				MetricLabelNamespace:       string(req.Namespace),
+				MetricLabelName:            string(req.Name),
+				MetricLabelConditionType:   string(observedCondition.Type),
+				MetricLabelConditionStatus: string(observedCondition.Status),
+			})
+			c.metrics.ConditionCurrentStatusSeconds.DeletePartialMatch(prometheus.Labels{
 				MetricLabelGroup:           gvk.Group,
 				MetricLabelKind:            gvk.Kind,
 				MetricLabelNamespace:       string(req.Namespace),
@@ -95,6 +670,54 @@ func (c *Controller[T]) Reconcile(ctx context.Context, req reconcile.Request) (r
 				MetricLabelConditionStatus: string(observedCondition.Status),
 			})
 		}
+		if currentConditions.Get(observedCondition.Type) == nil {
+			staleLabels := prometheus.Labels{
+				MetricLabelGroup:         gvk.Group,
+				MetricLabelKind:          gvk.Kind,
+				MetricLabelNamespace:     string(req.Namespace),
+				MetricLabelName:          string(req.Name),
+				MetricLabelConditionType: string(observedCondition.Type),
+			}
+			c.metrics.ConditionStale.Delete(staleLabels)
+			c.metrics.ConditionObservedGenerationLag.Delete(staleLabels)
+		}
+	}
+
+	// Detect and record reconciles once the object is ready, which may indicate wasteful reconciles in steady state
+	postReadyLabels := prometheus.Labels{
+		MetricLabelGroup:     gvk.Group,
+		MetricLabelKind:      gvk.Kind,
+		MetricLabelNamespace: string(req.Namespace),
+		MetricLabelName:      string(req.Name),
+	}
+	if currentConditions.Root().IsTrue() {
+		c.metrics.PostReadyReconciles.With(postReadyLabels).Inc()
+	} else {
+		c.metrics.PostReadyReconciles.Delete(postReadyLabels)
+	}
+
+	// Detect and record the difference between desired and observed state
+	if len(c.opts.SpecStatusFields) > 0 {
+		content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(o)
+		if err != nil {
+			return reconcile.Result{}, fmt.Errorf("converting object, %w", err)
+		}
+		for _, field := range c.opts.SpecStatusFields {
+			labels := prometheus.Labels{
+				MetricLabelGroup:     gvk.Group,
+				MetricLabelKind:      gvk.Kind,
+				MetricLabelNamespace: string(req.Namespace),
+				MetricLabelName:      string(req.Name),
+				MetricLabelField:     field.Name,
+			}
+			spec, specFound := nestedNumber(content, field.SpecPath...)
+			status, statusFound := nestedNumber(content, field.StatusPath...)
+			if !specFound || !statusFound {
+				c.metrics.SpecStatusDiff.Delete(labels)
+				continue
+			}
+			c.metrics.SpecStatusDiff.With(labels).Set(spec - status)
+		}
 	}
 
 	// Detect and record status transitions. This approach is best effort,
@@ -112,66 +735,354 @@ func (c *Controller[T]) Reconcile(ctx context.Context, req reconcile.Request) (r
 	// lossy, specifically for when a condition transition rapidly. However,
 	// for the common case, we want to alert when a transition took a long
 	// time, and our likelyhood of observing this is much higher.
-	for _, condition := range currentConditions.List() {
+	for _, condition := range storedConditions.List() {
 		observedCondition := observedConditions.Get(condition.Type)
-		if observedCondition == nil || observedCondition.GetStatus() == condition.GetStatus() {
+		if observedCondition == nil {
+			continue
+		}
+		if observedCondition.GetStatus() == condition.GetStatus() {
+			// Message churn without a status change can indicate a controller stuck retrying
+			if observedCondition.Message != condition.Message {
+				c.metrics.ConditionMessageChanges.With(lo.Assign(prometheus.Labels{
+					MetricLabelGroup:         gvk.Group,
+					MetricLabelKind:          gvk.Kind,
+					MetricLabelConditionType: string(condition.Type),
+				}, objectLabels)).Inc()
+			}
+			// Reason changes without a status change, e.g. a condition that stays False for a new reason. Conditions
+			// restored from a checkpoint don't know their reason, so can't be compared.
+			if observedCondition.Reason != "" && observedCondition.Reason != condition.Reason {
+				c.metrics.ConditionReasonChangesTotal.With(lo.Assign(prometheus.Labels{
+					MetricLabelGroup:           gvk.Group,
+					MetricLabelKind:            gvk.Kind,
+					MetricLabelConditionType:   string(condition.Type),
+					MetricLabelConditionStatus: string(condition.Status),
+					MetricLabelConditionReason: condition.Reason,
+				}, objectLabels)).Inc()
+				if c.eventRecorder != nil {
+					c.eventRecorder.AnnotatedEventf(o, map[string]string{
+						EventAnnotationFromStatus: string(observedCondition.Status),
+						EventAnnotationToStatus:   string(condition.Status),
+						EventAnnotationReason:     condition.Reason,
+					}, v1.EventTypeNormal, string(condition.Type), "Status condition reason changed, Type: %s, Reason: %s -> %s",
+						condition.Type,
+						observedCondition.Reason,
+						condition.Reason,
+					)
+				}
+			}
 			continue
 		}
-		duration := condition.LastTransitionTime.Time.Sub(observedCondition.LastTransitionTime.Time).Seconds()
-		ConditionDuration.With(prometheus.Labels{
+		// Conditions restored from a checkpoint don't know when they transitioned, so their duration is unknown
+		if !observedCondition.LastTransitionTime.IsZero() {
+			duration := condition.LastTransitionTime.Time.Sub(observedCondition.LastTransitionTime.Time).Seconds()
+			// Transition times are wall clock times, which may be adjusted backwards, e.g. by NTP
+			if duration < 0 {
+				c.metrics.NegativeDurations.With(prometheus.Labels{
+					MetricLabelGroup: gvk.Group,
+					MetricLabelKind:  gvk.Kind,
+				}).Inc()
+				duration = 0
+			}
+			c.metrics.ConditionDuration.With(lo.Assign(prometheus.Labels{
+				MetricLabelGroup:           gvk.Group,
+				MetricLabelKind:            gvk.Kind,
+				MetricLabelVersion:         gvk.Version,
+				MetricLabelConditionType:   string(observedCondition.Type),
+				MetricLabelConditionStatus: string(observedCondition.Status),
+			}, objectLabels)).Observe(float64(duration))
+		}
+		c.metrics.ConditionTransitionsTotal.With(lo.Assign(prometheus.Labels{
 			MetricLabelGroup:           gvk.Group,
 			MetricLabelKind:            gvk.Kind,
-			MetricLabelConditionType:   string(observedCondition.Type),
-			MetricLabelConditionStatus: string(observedCondition.Status),
-		}).Observe(float64(duration))
-		c.eventRecorder.Event(o, v1.EventTypeNormal, string(condition.Type), fmt.Sprintf("Status condition transitioned, Type: %s, Status: %s -> %s, Reason: %s%s",
-			condition.Type,
-			observedCondition.Status,
-			condition.Status,
-			condition.Reason,
-			lo.Ternary(condition.Message != "", fmt.Sprintf(", Message: %s", condition.Message), ""),
-		))
-	}
-	return reconcile.Result{}, nil
-}
-
-// Cardinality is limited to # objects * # conditions * # objectives
-var ConditionDuration = prometheus.NewHistogramVec(
-	prometheus.HistogramOpts{
-		Namespace: MetricNamespace,
-		Subsystem: MetricSubsystem,
-		Name:      "transition_seconds",
-		Help:      "The amount of time a condition was in a given state before transitioning. e.g. Alarm := P99(Updated=False) > 5 minutes",
-	},
-	[]string{
-		MetricLabelGroup,
-		MetricLabelKind,
-		MetricLabelConditionType,
-		MetricLabelConditionStatus,
-	},
-)
+			MetricLabelVersion:         gvk.Version,
+			MetricLabelConditionType:   string(condition.Type),
+			MetricLabelConditionStatus: string(condition.Status),
+			MetricLabelConditionReason: condition.Reason,
+		}, objectLabels)).Inc()
+		c.metrics.ConditionDirectedTransitionsTotal.With(lo.Assign(prometheus.Labels{
+			MetricLabelGroup:         gvk.Group,
+			MetricLabelKind:          gvk.Kind,
+			MetricLabelVersion:       gvk.Version,
+			MetricLabelConditionType: string(condition.Type),
+			MetricLabelFromStatus:    string(observedCondition.Status),
+			MetricLabelToStatus:      string(condition.Status),
+		}, objectLabels)).Inc()
+		transitions++
+		// Throttle informational transitions per object, while always emitting critical transitions
+		severity := c.opts.TransitionSeverity(*observedCondition, condition)
+		if severity.eventType() == v1.EventTypeNormal && c.opts.EventThrottle > 0 && c.throttled(req, now) {
+			continue
+		}
+		if c.eventRecorder != nil {
+			c.eventRecorder.AnnotatedEventf(o, map[string]string{
+				EventAnnotationFromStatus: string(observedCondition.Status),
+				EventAnnotationToStatus:   string(condition.Status),
+				EventAnnotationReason:     condition.Reason,
+			}, severity.eventType(), string(condition.Type), "%s", c.opts.EventMessageFunc(o, *observedCondition, condition))
+		}
+		for _, sink := range c.opts.EventSinks {
+			sink.Send(ctx, TransitionEvent{Object: o, Previous: *observedCondition, Current: condition, Severity: severity})
+		}
+	}
+	if c.opts.Checkpoint && !c.opts.ReadOnly {
+		if err := c.checkpoint(ctx, o, storedConditions); err != nil {
+			return reconcile.Result{}, err
+		}
+	}
+	// Requeue periodically to keep ConditionCurrentStatusSeconds fresh, and to record pending transitions
+	return reconcile.Result{RequeueAfter: requeueAfter}, nil
+}
 
-// Cardinality is limited to # objects * # conditions
-var ConditionCount = prometheus.NewGaugeVec(
-	prometheus.GaugeOpts{
-		Namespace: MetricNamespace,
-		Subsystem: MetricSubsystem,
-		Name:      "count",
-		Help:      "The number of an condition for a given object, type and status. e.g. Alarm := Available=False > 0",
-	},
-	[]string{
-		MetricLabelNamespace,
-		MetricLabelName,
-		MetricLabelGroup,
-		MetricLabelKind,
-		MetricLabelConditionType,
-		MetricLabelConditionStatus,
-	},
-)
+// throttled returns whether an informational event was emitted for the object within the EventThrottle, and
+// otherwise records that one is emitted now
+func (c *Controller[T]) throttled(req reconcile.Request, now time.Time) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if lastInfoEvent, ok := c.lastInfoEvent[req]; ok && now.Sub(lastInfoEvent) < c.opts.EventThrottle {
+		return true
+	}
+	c.lastInfoEvent[req] = now
+	return false
+}
 
-func init() {
-	metrics.Registry.MustRegister(
-		ConditionCount,
-		ConditionDuration,
+// transitionSeverity is the default TransitionSeverity, which considers all transitions informational
+func transitionSeverity(Condition, Condition) Severity {
+	return SeverityInfo
+}
+
+// eventMessage is the default EventMessageFunc
+func eventMessage(_ client.Object, previous, current Condition) string {
+	return fmt.Sprintf("Status condition transitioned, Type: %s, Status: %s -> %s, Reason: %s%s",
+		current.Type,
+		previous.Status,
+		current.Status,
+		current.Reason,
+		lo.Ternary(current.Message != "", fmt.Sprintf(", Message: %s", current.Message), ""),
 	)
 }
+
+// conditionCountValue is the default ConditionCountValue, which counts each condition once
+func conditionCountValue(client.Object, Condition) float64 {
+	return 1
+}
+
+// conditionsObject returns the Object holding the conditions of the object
+func (c *Controller[T]) conditionsObject(ctx context.Context, o T) (Object, error) {
+	if c.resolveConditions != nil {
+		return c.resolveConditions(ctx, o)
+	}
+	return c.statusObject(o), nil
+}
+
+// statusObject returns the object as an Object, adapting it with the accessors if its type doesn't implement Object
+func (c *Controller[T]) statusObject(o T) Object {
+	if so, ok := any(o).(Object); ok {
+		return so
+	}
+	return &accessorObject[T]{Object: o, accessors: c.accessors}
+}
+
+// mismatchedObject returns the object from which the conditions are read, if it's not the reconciled object, e.g. if
+// the conditions object or its StatusConditions hold a stale copy of the object
+func (c *Controller[T]) mismatchedObject(o T, so Object) (client.Object, bool) {
+	candidates := []client.Object{c.unwrap(so)}
+	if conditions := so.DeepCopyObject().(Object).StatusConditions(); conditions.object != nil {
+		candidates = append(candidates, c.unwrap(conditions.object))
+	}
+	return lo.Find(candidates, func(candidate client.Object) bool {
+		return candidate.GetUID() != o.GetUID() || candidate.GetResourceVersion() != o.GetResourceVersion()
+	})
+}
+
+// unwrap returns the object whose conditions are held by the Object, which may adapt the object
+func (c *Controller[T]) unwrap(o Object) client.Object {
+	switch wrapped := o.(type) {
+	case *accessorObject[T]:
+		return wrapped.Object
+	case *memoryObject:
+		return wrapped.Object
+	}
+	return o
+}
+
+// accessorObject implements Object for a type that doesn't, using ConditionAccessors
+type accessorObject[T client.Object] struct {
+	client.Object
+	accessors ConditionAccessors[T]
+}
+
+func (a *accessorObject[T]) GetConditions() []Condition {
+	return a.accessors.GetConditions(a.Object.(T))
+}
+
+func (a *accessorObject[T]) SetConditions(conditions []Condition) {
+	a.accessors.SetConditions(a.Object.(T), conditions)
+}
+
+func (a *accessorObject[T]) StatusConditions() ConditionSet {
+	return ConditionSet{object: a}
+}
+
+func (a *accessorObject[T]) DeepCopyObject() runtime.Object {
+	return &accessorObject[T]{Object: a.Object.DeepCopyObject().(client.Object), accessors: a.accessors}
+}
+
+// countObjectsByCondition adds delta to the number of objects with each of the condition statuses
+func (c *Controller[T]) countObjectsByCondition(gvk schema.GroupVersionKind, statuses map[string]string, delta float64) {
+	for conditionType, status := range statuses {
+		c.metrics.ObjectsByCondition.With(prometheus.Labels{
+			MetricLabelGroup:           gvk.Group,
+			MetricLabelKind:            gvk.Kind,
+			MetricLabelVersion:         gvk.Version,
+			MetricLabelConditionType:   conditionType,
+			MetricLabelConditionStatus: status,
+		}).Add(delta)
+	}
+}
+
+// terminationLabels returns the labels for the termination metrics of the object
+func (c *Controller[T]) terminationLabels(o T) prometheus.Labels {
+	gvk := object.GVK(o)
+	labels := prometheus.Labels{
+		MetricLabelGroup: gvk.Group,
+		MetricLabelKind:  gvk.Kind,
+	}
+	if c.opts.TerminationOwnerLabel {
+		labels[MetricLabelOwner] = ""
+		if owner := object.OwnerRef(o); owner != nil {
+			labels[MetricLabelOwner] = fmt.Sprintf("%s/%s", owner.Kind, owner.Name)
+		}
+	}
+	if c.opts.TerminationPropagationLabel {
+		labels[MetricLabelPropagation] = terminationPropagation(o)
+	}
+	if c.opts.TerminationReasonAnnotation != "" {
+		labels[MetricLabelTerminationReason] = o.GetAnnotations()[c.opts.TerminationReasonAnnotation]
+	}
+	return labels
+}
+
+// TerminationPropagationForced labels the termination of objects deleted with a zero grace period
+const TerminationPropagationForced = "Forced"
+
+// terminationPropagation returns how deletion of the object was requested. The propagation policy isn't persisted,
+// but the API server adds a finalizer for the Orphan and Foreground policies.
+func terminationPropagation(o client.Object) string {
+	switch {
+	case o.GetDeletionGracePeriodSeconds() != nil && *o.GetDeletionGracePeriodSeconds() == 0:
+		return TerminationPropagationForced
+	case object.HasFinalizer(o, metav1.FinalizerOrphanDependents):
+		return string(metav1.DeletePropagationOrphan)
+	case object.HasFinalizer(o, metav1.FinalizerDeleteDependents):
+		return string(metav1.DeletePropagationForeground)
+	default:
+		return string(metav1.DeletePropagationBackground)
+	}
+}
+
+// nestedNumber returns the numeric value of the field at the path, and false if the field is absent or not a number
+func nestedNumber(content map[string]interface{}, path ...string) (float64, bool) {
+	value, found, err := unstructured.NestedFieldNoCopy(content, path...)
+	if !found || err != nil {
+		return 0, false
+	}
+	switch v := value.(type) {
+	case int64:
+		return float64(v), true
+	case float64:
+		return v, true
+	default:
+		return 0, false
+	}
+}
+
+// metricNamespace returns the prefix of the metric names, see ControllerOpts.MetricNamespace and MetricSubsystem
+func (c *Controller[T]) metricNamespace() string {
+	return strings.Join(lo.Compact([]string{c.opts.MetricNamespace, c.opts.MetricSubsystem}), "_")
+}
+
+// labelNames returns the sorted names of the labels added to the condition metrics, see metricLabels
+func (c *Controller[T]) labelNames() []string {
+	labels := append(lo.Values(c.opts.AnnotationLabels), c.opts.ContextLabelNames...)
+	if c.opts.External {
+		labels = append(labels, MetricLabelExternal)
+	}
+	sort.Strings(labels)
+	return labels
+}
+
+// annotationLabels returns the labels added to the condition metrics of the object, which are derived from the
+// object's annotations, see ControllerOpts.AnnotationLabels, and mark external objects, see ControllerOpts.External
+func (c *Controller[T]) annotationLabels(o client.Object) prometheus.Labels {
+	labels := prometheus.Labels{}
+	for key, label := range c.opts.AnnotationLabels {
+		labels[label] = o.GetAnnotations()[key]
+	}
+	if c.opts.External {
+		labels[MetricLabelExternal] = "true"
+	}
+	return labels
+}
+
+// contextLabelValues evaluates the labels added to the condition metrics by the context, see ControllerOpts.ContextLabels
+func (c *Controller[T]) contextLabelValues(ctx context.Context) prometheus.Labels {
+	if c.opts.ContextLabels == nil {
+		return prometheus.Labels{}
+	}
+	values := c.opts.ContextLabels(ctx)
+	return lo.SliceToMap(c.opts.ContextLabelNames, func(label string) (string, string) { return label, values[label] })
+}
+
+// metricLabels returns the labels with which the condition metrics of the object were last emitted, which are derived
+// from its annotations and the context of its last reconcile
+func (c *Controller[T]) metricLabels(req reconcile.Request, o client.Object) prometheus.Labels {
+	return lo.Assign(c.annotationLabels(o), c.contextLabels[req])
+}
+
+// MetricSample is a single series emitted by the status controller
+type MetricSample struct {
+	Name   string
+	Labels map[string]string
+	Value  float64
+}
+
+// TerminatingObjects returns a snapshot of the objects that this controller has observed terminating, and that
+// haven't yet been deleted, with the time at which their deletion was requested
+func (c *Controller[T]) TerminatingObjects() map[types.NamespacedName]time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return lo.MapEntries(c.terminatingObjects, func(req reconcile.Request, o T) (types.NamespacedName, time.Time) {
+		return req.NamespacedName, o.GetDeletionTimestamp().Time
+	})
+}
+
+// MetricsSnapshot returns all series currently emitted for the kind reconciled by this controller. Histograms
+// are represented as a pair of samples suffixed by _count and _sum, following the Prometheus exposition format.
+func (c *Controller[T]) MetricsSnapshot() []MetricSample {
+	gvk := object.GVK(object.New[T]())
+	var samples []MetricSample
+	for _, family := range lo.Must(metrics.Registry.Gather()) {
+		if !strings.HasPrefix(family.GetName(), fmt.Sprintf("%s_%s", c.metricNamespace(), StatusMetricSubsystem)) {
+			continue
+		}
+		for _, metric := range family.GetMetric() {
+			labels := lo.SliceToMap(metric.GetLabel(), func(label *dto.LabelPair) (string, string) { return label.GetName(), label.GetValue() })
+			if labels[MetricLabelGroup] != gvk.Group || labels[MetricLabelKind] != gvk.Kind {
+				continue
+			}
+			switch family.GetType() {
+			case dto.MetricType_GAUGE:
+				samples = append(samples, MetricSample{Name: family.GetName(), Labels: labels, Value: metric.GetGauge().GetValue()})
+			case dto.MetricType_COUNTER:
+				samples = append(samples, MetricSample{Name: family.GetName(), Labels: labels, Value: metric.GetCounter().GetValue()})
+			case dto.MetricType_HISTOGRAM:
+				samples = append(samples,
+					MetricSample{Name: family.GetName() + "_count", Labels: labels, Value: float64(metric.GetHistogram().GetSampleCount())},
+					MetricSample{Name: family.GetName() + "_sum", Labels: labels, Value: metric.GetHistogram().GetSampleSum()},
+				)
+			}
+		}
+	}
+	return samples
+}
@@ -2,8 +2,11 @@ package status_test
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"time"
 
+	"github.com/awslabs/operatorpkg/object"
 	"github.com/awslabs/operatorpkg/status"
 	"github.com/awslabs/operatorpkg/test"
 	. "github.com/awslabs/operatorpkg/test/expectations"
@@ -12,11 +15,22 @@ import (
 	. "github.com/onsi/gomega"
 	prometheus "github.com/prometheus/client_model/go"
 	"github.com/samber/lo"
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/tools/record"
-	"sigs.k8s.io/controller-runtime/pkg/client"
+	"k8s.io/client-go/util/workqueue"
+	clocktesting "k8s.io/utils/clock/testing"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/metrics"
 )
@@ -25,13 +39,16 @@ var _ = Describe("Controller", func() {
 	var ctx context.Context
 	var recorder *record.FakeRecorder
 	var controller *status.Controller[*TestObject]
-	var client client.Client
+	var client ctrlclient.Client
 	BeforeEach(func() {
 		recorder = record.NewFakeRecorder(10)
 		client = fake.NewClientBuilder().WithScheme(scheme.Scheme).Build()
 		controller = status.NewController[*TestObject](client, recorder)
 		ctx = log.IntoContext(context.Background(), ginkgo.GinkgoLogr)
 	})
+	AfterEach(func() {
+		controller.Unregister()
+	})
 
 	It("should emit metrics and events on a transition", func() {
 		testObject := test.Object(&TestObject{})
@@ -40,16 +57,16 @@ var _ = Describe("Controller", func() {
 		// conditions not set
 		ExpectApplied(ctx, client, testObject)
 		ExpectReconciled(ctx, controller, testObject)
-		Expect(GetMetric("operator_status_condition_count", conditionLabels(status.ConditionReady, metav1.ConditionTrue))).To(BeNil())
-		Expect(GetMetric("operator_status_condition_count", conditionLabels(status.ConditionReady, metav1.ConditionFalse))).To(BeNil())
-		Expect(GetMetric("operator_status_condition_count", conditionLabels(status.ConditionReady, metav1.ConditionUnknown)).GetGauge().GetValue()).To(BeEquivalentTo(1))
-		Expect(GetMetric("operator_status_condition_count", conditionLabels(ConditionTypeFoo, metav1.ConditionTrue))).To(BeNil())
-		Expect(GetMetric("operator_status_condition_count", conditionLabels(ConditionTypeFoo, metav1.ConditionFalse))).To(BeNil())
-		Expect(GetMetric("operator_status_condition_count", conditionLabels(ConditionTypeFoo, metav1.ConditionUnknown)).GetGauge().GetValue()).To(BeEquivalentTo(1))
-		Expect(GetMetric("operator_status_condition_count", conditionLabels(ConditionTypeBar, metav1.ConditionTrue))).To(BeNil())
-		Expect(GetMetric("operator_status_condition_count", conditionLabels(ConditionTypeBar, metav1.ConditionFalse))).To(BeNil())
-		Expect(GetMetric("operator_status_condition_count", conditionLabels(ConditionTypeBar, metav1.ConditionUnknown)).GetGauge().GetValue()).To(BeEquivalentTo(1))
-		Expect(GetMetric("operator_status_condition_transition_seconds")).To(BeNil())
+		ExpectMetricNotFound("operator_status_condition_count", conditionLabels(status.ConditionReady, metav1.ConditionTrue))
+		ExpectMetricNotFound("operator_status_condition_count", conditionLabels(status.ConditionReady, metav1.ConditionFalse))
+		ExpectMetricValue("operator_status_condition_count", conditionLabels(status.ConditionReady, metav1.ConditionUnknown), 1)
+		ExpectMetricNotFound("operator_status_condition_count", conditionLabels(ConditionTypeFoo, metav1.ConditionTrue))
+		ExpectMetricNotFound("operator_status_condition_count", conditionLabels(ConditionTypeFoo, metav1.ConditionFalse))
+		ExpectMetricValue("operator_status_condition_count", conditionLabels(ConditionTypeFoo, metav1.ConditionUnknown), 1)
+		ExpectMetricNotFound("operator_status_condition_count", conditionLabels(ConditionTypeBar, metav1.ConditionTrue))
+		ExpectMetricNotFound("operator_status_condition_count", conditionLabels(ConditionTypeBar, metav1.ConditionFalse))
+		ExpectMetricValue("operator_status_condition_count", conditionLabels(ConditionTypeBar, metav1.ConditionUnknown), 1)
+		ExpectMetricNotFound("operator_status_condition_transition_seconds", nil)
 		Eventually(recorder.Events).Should(BeEmpty())
 
 		// Transition Foo
@@ -59,27 +76,28 @@ var _ = Describe("Controller", func() {
 		ExpectReconciled(ctx, controller, testObject)
 		ExpectStatusConditions(ctx, client, FastTimeout, testObject, status.Condition{Type: ConditionTypeFoo, Status: metav1.ConditionTrue})
 
-		Expect(GetMetric("operator_status_condition_count", conditionLabels(status.ConditionReady, metav1.ConditionTrue))).To(BeNil())
-		Expect(GetMetric("operator_status_condition_count", conditionLabels(status.ConditionReady, metav1.ConditionFalse))).To(BeNil())
-		Expect(GetMetric("operator_status_condition_count", conditionLabels(status.ConditionReady, metav1.ConditionUnknown)).GetGauge().GetValue()).To(BeEquivalentTo(1))
-		Expect(GetMetric("operator_status_condition_count", conditionLabels(ConditionTypeFoo, metav1.ConditionTrue)).GetGauge().GetValue()).To(BeEquivalentTo(1))
-		Expect(GetMetric("operator_status_condition_count", conditionLabels(ConditionTypeFoo, metav1.ConditionFalse))).To(BeNil())
-		Expect(GetMetric("operator_status_condition_count", conditionLabels(ConditionTypeFoo, metav1.ConditionUnknown))).To(BeNil())
-		Expect(GetMetric("operator_status_condition_count", conditionLabels(ConditionTypeBar, metav1.ConditionTrue))).To(BeNil())
-		Expect(GetMetric("operator_status_condition_count", conditionLabels(ConditionTypeBar, metav1.ConditionFalse))).To(BeNil())
-		Expect(GetMetric("operator_status_condition_count", conditionLabels(ConditionTypeBar, metav1.ConditionUnknown)).GetGauge().GetValue()).To(BeEquivalentTo(1))
-
-		Expect(GetMetric("operator_status_condition_transition_seconds", conditionLabels(status.ConditionReady, metav1.ConditionTrue))).To(BeNil())
-		Expect(GetMetric("operator_status_condition_transition_seconds", conditionLabels(status.ConditionReady, metav1.ConditionFalse))).To(BeNil())
-		Expect(GetMetric("operator_status_condition_transition_seconds", conditionLabels(status.ConditionReady, metav1.ConditionUnknown))).To(BeNil())
-		Expect(GetMetric("operator_status_condition_transition_seconds", conditionLabels(ConditionTypeFoo, metav1.ConditionTrue))).To(BeNil())
-		Expect(GetMetric("operator_status_condition_transition_seconds", conditionLabels(ConditionTypeFoo, metav1.ConditionFalse))).To(BeNil())
-		Expect(GetMetric("operator_status_condition_transition_seconds", conditionLabels(ConditionTypeFoo, metav1.ConditionUnknown)).GetHistogram().GetSampleCount()).To(BeNumerically(">", 0))
-		Expect(GetMetric("operator_status_condition_transition_seconds", conditionLabels(ConditionTypeBar, metav1.ConditionTrue))).To(BeNil())
-		Expect(GetMetric("operator_status_condition_transition_seconds", conditionLabels(ConditionTypeBar, metav1.ConditionFalse))).To(BeNil())
-		Expect(GetMetric("operator_status_condition_transition_seconds", conditionLabels(ConditionTypeBar, metav1.ConditionUnknown))).To(BeNil())
-
-		Expect(recorder.Events).To(Receive(Equal("Normal Foo Status condition transitioned, Type: Foo, Status: Unknown -> True, Reason: Foo")))
+		ExpectMetricNotFound("operator_status_condition_count", conditionLabels(status.ConditionReady, metav1.ConditionTrue))
+		ExpectMetricNotFound("operator_status_condition_count", conditionLabels(status.ConditionReady, metav1.ConditionFalse))
+		ExpectMetricValue("operator_status_condition_count", conditionLabels(status.ConditionReady, metav1.ConditionUnknown), 1)
+		ExpectMetricValue("operator_status_condition_count", conditionLabels(ConditionTypeFoo, metav1.ConditionTrue), 1)
+		ExpectMetricNotFound("operator_status_condition_count", conditionLabels(ConditionTypeFoo, metav1.ConditionFalse))
+		ExpectMetricNotFound("operator_status_condition_count", conditionLabels(ConditionTypeFoo, metav1.ConditionUnknown))
+		ExpectMetricNotFound("operator_status_condition_count", conditionLabels(ConditionTypeBar, metav1.ConditionTrue))
+		ExpectMetricNotFound("operator_status_condition_count", conditionLabels(ConditionTypeBar, metav1.ConditionFalse))
+		ExpectMetricValue("operator_status_condition_count", conditionLabels(ConditionTypeBar, metav1.ConditionUnknown), 1)
+
+		ExpectMetricNotFound("operator_status_condition_transition_seconds", conditionLabels(status.ConditionReady, metav1.ConditionTrue))
+		ExpectMetricNotFound("operator_status_condition_transition_seconds", conditionLabels(status.ConditionReady, metav1.ConditionFalse))
+		ExpectMetricNotFound("operator_status_condition_transition_seconds", conditionLabels(status.ConditionReady, metav1.ConditionUnknown))
+		ExpectMetricNotFound("operator_status_condition_transition_seconds", conditionLabels(ConditionTypeFoo, metav1.ConditionTrue))
+		ExpectMetricNotFound("operator_status_condition_transition_seconds", conditionLabels(ConditionTypeFoo, metav1.ConditionFalse))
+		Expect(MetricValue("operator_status_condition_transition_seconds", conditionLabels(ConditionTypeFoo, metav1.ConditionUnknown))).To(BeNumerically(">", 0))
+		ExpectMetricNotFound("operator_status_condition_transition_seconds", conditionLabels(ConditionTypeBar, metav1.ConditionTrue))
+		ExpectMetricNotFound("operator_status_condition_transition_seconds", conditionLabels(ConditionTypeBar, metav1.ConditionFalse))
+		ExpectMetricNotFound("operator_status_condition_transition_seconds", conditionLabels(ConditionTypeBar, metav1.ConditionUnknown))
+
+		Expect(MetricValue("operator_status_condition_transitions_total", conditionLabels(ConditionTypeFoo, metav1.ConditionTrue))).To(BeNumerically(">", 0))
+		Expect(recorder.Events).To(Receive(Equal("Normal Foo Status condition transitioned, Type: Foo, Status: Unknown -> True, Reason: Foo map[operatorpkg.k8s.aws/from-status:Unknown operatorpkg.k8s.aws/reason:Foo operatorpkg.k8s.aws/to-status:True]")))
 
 		// Transition Bar, root condition should also flip
 		testObject.StatusConditions().SetTrueWithReason(ConditionTypeBar, "reason", "message")
@@ -87,64 +105,1511 @@ var _ = Describe("Controller", func() {
 		ExpectReconciled(ctx, controller, testObject)
 		ExpectStatusConditions(ctx, client, FastTimeout, testObject, status.Condition{Type: ConditionTypeBar, Status: metav1.ConditionTrue, Reason: "reason", Message: "message"})
 
-		Expect(GetMetric("operator_status_condition_count", conditionLabels(status.ConditionReady, metav1.ConditionTrue)).GetGauge().GetValue()).To(BeEquivalentTo(1))
-		Expect(GetMetric("operator_status_condition_count", conditionLabels(status.ConditionReady, metav1.ConditionFalse))).To(BeNil())
-		Expect(GetMetric("operator_status_condition_count", conditionLabels(status.ConditionReady, metav1.ConditionUnknown))).To(BeNil())
-		Expect(GetMetric("operator_status_condition_count", conditionLabels(ConditionTypeFoo, metav1.ConditionTrue)).GetGauge().GetValue()).To(BeEquivalentTo(1))
-		Expect(GetMetric("operator_status_condition_count", conditionLabels(ConditionTypeFoo, metav1.ConditionFalse))).To(BeNil())
-		Expect(GetMetric("operator_status_condition_count", conditionLabels(ConditionTypeFoo, metav1.ConditionUnknown))).To(BeNil())
-		Expect(GetMetric("operator_status_condition_count", conditionLabels(ConditionTypeBar, metav1.ConditionTrue)).GetGauge().GetValue()).To(BeEquivalentTo(1))
-		Expect(GetMetric("operator_status_condition_count", conditionLabels(ConditionTypeBar, metav1.ConditionFalse))).To(BeNil())
-		Expect(GetMetric("operator_status_condition_count", conditionLabels(ConditionTypeBar, metav1.ConditionUnknown))).To(BeNil())
-
-		Expect(GetMetric("operator_status_condition_transition_seconds", conditionLabels(status.ConditionReady, metav1.ConditionTrue))).To(BeNil())
-		Expect(GetMetric("operator_status_condition_transition_seconds", conditionLabels(status.ConditionReady, metav1.ConditionFalse))).To(BeNil())
-		Expect(GetMetric("operator_status_condition_transition_seconds", conditionLabels(status.ConditionReady, metav1.ConditionUnknown)).GetHistogram().GetSampleCount()).To(BeNumerically(">", 0))
-		Expect(GetMetric("operator_status_condition_transition_seconds", conditionLabels(ConditionTypeFoo, metav1.ConditionTrue))).To(BeNil())
-		Expect(GetMetric("operator_status_condition_transition_seconds", conditionLabels(ConditionTypeFoo, metav1.ConditionFalse))).To(BeNil())
-		Expect(GetMetric("operator_status_condition_transition_seconds", conditionLabels(ConditionTypeFoo, metav1.ConditionUnknown)).GetHistogram().GetSampleCount()).To(BeNumerically(">", 0))
-		Expect(GetMetric("operator_status_condition_transition_seconds", conditionLabels(ConditionTypeBar, metav1.ConditionTrue))).To(BeNil())
-		Expect(GetMetric("operator_status_condition_transition_seconds", conditionLabels(ConditionTypeBar, metav1.ConditionFalse))).To(BeNil())
-		Expect(GetMetric("operator_status_condition_transition_seconds", conditionLabels(ConditionTypeBar, metav1.ConditionUnknown)).GetHistogram().GetSampleCount()).To(BeNumerically(">", 0))
-
-		Expect(recorder.Events).To(Receive(Equal("Normal Bar Status condition transitioned, Type: Bar, Status: Unknown -> True, Reason: reason, Message: message")))
-		Expect(recorder.Events).To(Receive(Equal("Normal Ready Status condition transitioned, Type: Ready, Status: Unknown -> True, Reason: Ready")))
+		ExpectMetricValue("operator_status_condition_count", conditionLabels(status.ConditionReady, metav1.ConditionTrue), 1)
+		ExpectMetricNotFound("operator_status_condition_count", conditionLabels(status.ConditionReady, metav1.ConditionFalse))
+		ExpectMetricNotFound("operator_status_condition_count", conditionLabels(status.ConditionReady, metav1.ConditionUnknown))
+		ExpectMetricValue("operator_status_condition_count", conditionLabels(ConditionTypeFoo, metav1.ConditionTrue), 1)
+		ExpectMetricNotFound("operator_status_condition_count", conditionLabels(ConditionTypeFoo, metav1.ConditionFalse))
+		ExpectMetricNotFound("operator_status_condition_count", conditionLabels(ConditionTypeFoo, metav1.ConditionUnknown))
+		ExpectMetricValue("operator_status_condition_count", conditionLabels(ConditionTypeBar, metav1.ConditionTrue), 1)
+		ExpectMetricNotFound("operator_status_condition_count", conditionLabels(ConditionTypeBar, metav1.ConditionFalse))
+		ExpectMetricNotFound("operator_status_condition_count", conditionLabels(ConditionTypeBar, metav1.ConditionUnknown))
+
+		ExpectMetricNotFound("operator_status_condition_transition_seconds", conditionLabels(status.ConditionReady, metav1.ConditionTrue))
+		ExpectMetricNotFound("operator_status_condition_transition_seconds", conditionLabels(status.ConditionReady, metav1.ConditionFalse))
+		Expect(MetricValue("operator_status_condition_transition_seconds", conditionLabels(status.ConditionReady, metav1.ConditionUnknown))).To(BeNumerically(">", 0))
+		ExpectMetricNotFound("operator_status_condition_transition_seconds", conditionLabels(ConditionTypeFoo, metav1.ConditionTrue))
+		ExpectMetricNotFound("operator_status_condition_transition_seconds", conditionLabels(ConditionTypeFoo, metav1.ConditionFalse))
+		Expect(MetricValue("operator_status_condition_transition_seconds", conditionLabels(ConditionTypeFoo, metav1.ConditionUnknown))).To(BeNumerically(">", 0))
+		ExpectMetricNotFound("operator_status_condition_transition_seconds", conditionLabels(ConditionTypeBar, metav1.ConditionTrue))
+		ExpectMetricNotFound("operator_status_condition_transition_seconds", conditionLabels(ConditionTypeBar, metav1.ConditionFalse))
+		Expect(MetricValue("operator_status_condition_transition_seconds", conditionLabels(ConditionTypeBar, metav1.ConditionUnknown))).To(BeNumerically(">", 0))
+
+		Expect(recorder.Events).To(Receive(Equal("Normal Bar Status condition transitioned, Type: Bar, Status: Unknown -> True, Reason: reason, Message: message map[operatorpkg.k8s.aws/from-status:Unknown operatorpkg.k8s.aws/reason:reason operatorpkg.k8s.aws/to-status:True]")))
+		Expect(recorder.Events).To(Receive(Equal("Normal Ready Status condition transitioned, Type: Ready, Status: Unknown -> True, Reason: Ready map[operatorpkg.k8s.aws/from-status:Unknown operatorpkg.k8s.aws/reason:Ready operatorpkg.k8s.aws/to-status:True]")))
 
 		// Delete the object, state should clear
 		ExpectDeleted(ctx, client, testObject)
 		ExpectReconciled(ctx, controller, testObject)
 
-		Expect(GetMetric("operator_status_condition_count", conditionLabels(status.ConditionReady, metav1.ConditionTrue))).To(BeNil())
-		Expect(GetMetric("operator_status_condition_count", conditionLabels(status.ConditionReady, metav1.ConditionFalse))).To(BeNil())
-		Expect(GetMetric("operator_status_condition_count", conditionLabels(status.ConditionReady, metav1.ConditionUnknown))).To(BeNil())
-		Expect(GetMetric("operator_status_condition_count", conditionLabels(ConditionTypeFoo, metav1.ConditionTrue))).To(BeNil())
-		Expect(GetMetric("operator_status_condition_count", conditionLabels(ConditionTypeFoo, metav1.ConditionFalse))).To(BeNil())
-		Expect(GetMetric("operator_status_condition_count", conditionLabels(ConditionTypeFoo, metav1.ConditionUnknown))).To(BeNil())
-		Expect(GetMetric("operator_status_condition_count", conditionLabels(ConditionTypeBar, metav1.ConditionTrue))).To(BeNil())
-		Expect(GetMetric("operator_status_condition_count", conditionLabels(ConditionTypeBar, metav1.ConditionFalse))).To(BeNil())
-		Expect(GetMetric("operator_status_condition_count", conditionLabels(ConditionTypeBar, metav1.ConditionUnknown))).To(BeNil())
+		ExpectMetricNotFound("operator_status_condition_count", conditionLabels(status.ConditionReady, metav1.ConditionTrue))
+		ExpectMetricNotFound("operator_status_condition_count", conditionLabels(status.ConditionReady, metav1.ConditionFalse))
+		ExpectMetricNotFound("operator_status_condition_count", conditionLabels(status.ConditionReady, metav1.ConditionUnknown))
+		ExpectMetricNotFound("operator_status_condition_count", conditionLabels(ConditionTypeFoo, metav1.ConditionTrue))
+		ExpectMetricNotFound("operator_status_condition_count", conditionLabels(ConditionTypeFoo, metav1.ConditionFalse))
+		ExpectMetricNotFound("operator_status_condition_count", conditionLabels(ConditionTypeFoo, metav1.ConditionUnknown))
+		ExpectMetricNotFound("operator_status_condition_count", conditionLabels(ConditionTypeBar, metav1.ConditionTrue))
+		ExpectMetricNotFound("operator_status_condition_count", conditionLabels(ConditionTypeBar, metav1.ConditionFalse))
+		ExpectMetricNotFound("operator_status_condition_count", conditionLabels(ConditionTypeBar, metav1.ConditionUnknown))
 	})
-})
 
-// GetMetric attempts to find a metric given name and labels
-// If no metric is found, the *prometheus.Metric will be nil
-func GetMetric(name string, labels ...map[string]string) *prometheus.Metric {
-	family, found := lo.Find(lo.Must(metrics.Registry.Gather()), func(family *prometheus.MetricFamily) bool { return family.GetName() == name })
-	if !found {
-		return nil
-	}
-	for _, m := range family.Metric {
-		temp := lo.Assign(labels...)
-		for _, labelPair := range m.Label {
-			if v, ok := temp[labelPair.GetName()]; ok && v == labelPair.GetValue() {
-				delete(temp, labelPair.GetName())
+	It("should not emit a transition when a condition is touched", func() {
+		testObject := test.Object(&TestObject{})
+		testObject.StatusConditions().SetFalse(ConditionTypeFoo, "reason", "message")
+		ExpectApplied(ctx, client, testObject)
+		ExpectReconciled(ctx, controller, testObject)
+		transitions := MetricValue("operator_status_condition_transition_seconds", conditionLabels(ConditionTypeFoo, metav1.ConditionFalse))
+
+		time.Sleep(time.Second * 1)
+		Expect(testObject.StatusConditions().Touch(ConditionTypeFoo)).To(BeTrue())
+		ExpectApplied(ctx, client, testObject)
+		ExpectReconciled(ctx, controller, testObject)
+
+		Expect(MetricValue("operator_status_condition_transition_seconds", conditionLabels(ConditionTypeFoo, metav1.ConditionFalse))).To(Equal(transitions))
+		ExpectMetricValue("operator_status_condition_count", conditionLabels(ConditionTypeFoo, metav1.ConditionFalse), 1)
+		Expect(recorder.Events).To(BeEmpty())
+	})
+
+	It("should not compute current status seconds relative to a zero LastTransitionTime", func() {
+		fakeClock := clocktesting.NewFakeClock(time.Now())
+		controller = status.NewController[*TestObject](client, recorder, status.ControllerOpts{Clock: fakeClock})
+		testObject := test.Object(&TestObject{})
+		testObject.SetConditions([]status.Condition{{Type: ConditionTypeFoo, Status: metav1.ConditionFalse, Reason: "reason"}})
+		ExpectApplied(ctx, client, testObject)
+		Expect(testObject.StatusConditions().Get(ConditionTypeFoo).LastTransitionTime.IsZero()).To(BeTrue())
+		labels := lo.Assign(map[string]string{status.MetricLabelName: testObject.Name}, conditionLabels(ConditionTypeFoo, metav1.ConditionFalse))
+
+		ExpectReconciled(ctx, controller, testObject)
+		ExpectMetricValue("operator_status_condition_current_status_seconds", labels, 0)
+		fakeClock.Step(time.Second)
+		ExpectReconciled(ctx, controller, testObject)
+		ExpectMetricValue("operator_status_condition_current_status_seconds", labels, 1)
+	})
+
+	It("should count message changes without a status change", func() {
+		testObject := test.Object(&TestObject{})
+		testObject.StatusConditions().SetFalse(ConditionTypeFoo, "reason", "message")
+		ExpectApplied(ctx, client, testObject)
+		ExpectReconciled(ctx, controller, testObject)
+		messageChanges := MetricValue("operator_status_condition_message_changes_total", map[string]string{status.MetricLabelConditionType: ConditionTypeFoo})
+		transitions := MetricValue("operator_status_condition_transitions_total", conditionLabels(ConditionTypeFoo, metav1.ConditionFalse))
+
+		testObject.StatusConditions().SetFalse(ConditionTypeFoo, "reason", "another-message")
+		ExpectApplied(ctx, client, testObject)
+		ExpectReconciled(ctx, controller, testObject)
+		ExpectMetricValue("operator_status_condition_message_changes_total", map[string]string{status.MetricLabelConditionType: ConditionTypeFoo}, messageChanges+1)
+		Expect(MetricValue("operator_status_condition_transitions_total", conditionLabels(ConditionTypeFoo, metav1.ConditionFalse))).To(Equal(transitions))
+
+		// Reconciling without changes doesn't count
+		ExpectReconciled(ctx, controller, testObject)
+		ExpectMetricValue("operator_status_condition_message_changes_total", map[string]string{status.MetricLabelConditionType: ConditionTypeFoo}, messageChanges+1)
+	})
+
+	It("should count transitions by direction", func() {
+		directedLabels := func(from, to metav1.ConditionStatus) map[string]string {
+			return map[string]string{status.MetricLabelConditionType: ConditionTypeFoo, status.MetricLabelFromStatus: string(from), status.MetricLabelToStatus: string(to)}
+		}
+		testObject := test.Object(&TestObject{})
+		testObject.StatusConditions().SetFalse(ConditionTypeFoo, "reason", "message")
+		ExpectApplied(ctx, client, testObject)
+		ExpectReconciled(ctx, controller, testObject)
+		recoveries := MetricValue("operator_status_condition_directed_transitions_total", directedLabels(metav1.ConditionFalse, metav1.ConditionTrue))
+		regressions := MetricValue("operator_status_condition_directed_transitions_total", directedLabels(metav1.ConditionTrue, metav1.ConditionFalse))
+
+		testObject.StatusConditions().SetTrue(ConditionTypeFoo)
+		ExpectApplied(ctx, client, testObject)
+		ExpectReconciled(ctx, controller, testObject)
+		ExpectMetricValue("operator_status_condition_directed_transitions_total", directedLabels(metav1.ConditionFalse, metav1.ConditionTrue), recoveries+1)
+		Expect(MetricValue("operator_status_condition_directed_transitions_total", directedLabels(metav1.ConditionTrue, metav1.ConditionFalse))).To(Equal(regressions))
+
+		testObject.StatusConditions().SetFalse(ConditionTypeFoo, "reason", "message")
+		ExpectApplied(ctx, client, testObject)
+		ExpectReconciled(ctx, controller, testObject)
+		ExpectMetricValue("operator_status_condition_directed_transitions_total", directedLabels(metav1.ConditionFalse, metav1.ConditionTrue), recoveries+1)
+		ExpectMetricValue("operator_status_condition_directed_transitions_total", directedLabels(metav1.ConditionTrue, metav1.ConditionFalse), regressions+1)
+	})
+
+	It("should never write to the API server in read only mode", func() {
+		var writes []string
+		baseClient := fake.NewClientBuilder().WithScheme(scheme.Scheme).Build()
+		readOnlyClient := interceptor.NewClient(baseClient, interceptor.Funcs{
+			Create: func(ctx context.Context, c ctrlclient.WithWatch, obj ctrlclient.Object, opts ...ctrlclient.CreateOption) error {
+				writes = append(writes, "create")
+				return fmt.Errorf("forbidden")
+			},
+			Update: func(ctx context.Context, c ctrlclient.WithWatch, obj ctrlclient.Object, opts ...ctrlclient.UpdateOption) error {
+				writes = append(writes, "update")
+				return fmt.Errorf("forbidden")
+			},
+			Patch: func(ctx context.Context, c ctrlclient.WithWatch, obj ctrlclient.Object, patch ctrlclient.Patch, opts ...ctrlclient.PatchOption) error {
+				writes = append(writes, "patch")
+				return fmt.Errorf("forbidden")
+			},
+			Delete: func(ctx context.Context, c ctrlclient.WithWatch, obj ctrlclient.Object, opts ...ctrlclient.DeleteOption) error {
+				writes = append(writes, "delete")
+				return fmt.Errorf("forbidden")
+			},
+			SubResourceUpdate: func(ctx context.Context, c ctrlclient.Client, subResourceName string, obj ctrlclient.Object, opts ...ctrlclient.SubResourceUpdateOption) error {
+				writes = append(writes, "subresource update")
+				return fmt.Errorf("forbidden")
+			},
+			SubResourcePatch: func(ctx context.Context, c ctrlclient.Client, subResourceName string, obj ctrlclient.Object, patch ctrlclient.Patch, opts ...ctrlclient.SubResourcePatchOption) error {
+				writes = append(writes, "subresource patch")
+				return fmt.Errorf("forbidden")
+			},
+		})
+		controller = status.NewController[*TestObject](readOnlyClient, recorder, status.ControllerOpts{ReadOnly: true})
+
+		testObject := test.Object(&TestObject{})
+		ExpectApplied(ctx, baseClient, testObject)
+		ExpectReconciled(ctx, controller, testObject)
+		testObject.StatusConditions().SetTrue(ConditionTypeFoo)
+		ExpectApplied(ctx, baseClient, testObject)
+		ExpectReconciled(ctx, controller, testObject)
+		ExpectDeleted(ctx, baseClient, testObject)
+		ExpectReconciled(ctx, controller, testObject)
+		Expect(writes).To(BeEmpty())
+	})
+
+	It("should observe the age of conditions when the object is deleted", func() {
+		fakeClock := clocktesting.NewFakeClock(time.Now())
+		controller = status.NewController[*TestObject](client, recorder, status.ControllerOpts{Clock: fakeClock})
+		testObject := test.Object(&TestObject{})
+		testObject.StatusConditions().SetTrue(ConditionTypeFoo)
+		testObject.Status.Conditions = lo.Map(testObject.Status.Conditions, func(condition status.Condition, _ int) status.Condition {
+			condition.LastTransitionTime = metav1.NewTime(fakeClock.Now().Add(-time.Hour))
+			return condition
+		})
+		ExpectApplied(ctx, client, testObject)
+		ExpectReconciled(ctx, controller, testObject)
+		labels := lo.Assign(map[string]string{status.MetricLabelVersion: "v1alpha1"}, conditionLabels(ConditionTypeFoo, metav1.ConditionTrue))
+		count, sum := MetricValue("operator_status_condition_age_at_deletion_seconds", labels), MetricSum("operator_status_condition_age_at_deletion_seconds", labels)
+
+		fakeClock.Step(time.Minute)
+		ExpectDeleted(ctx, client, testObject)
+		ExpectReconciled(ctx, controller, testObject)
+		ExpectMetricValue("operator_status_condition_age_at_deletion_seconds", labels, count+1)
+		Expect(MetricSum("operator_status_condition_age_at_deletion_seconds", labels)).To(BeNumerically("~", sum+61*60, 1))
+	})
+
+	It("should count cold observations of an object", func() {
+		labels := map[string]string{status.MetricLabelKind: "TestObject"}
+		count := MetricValue("operator_status_cold_observations_total", labels)
+		testObject := test.Object(&TestObject{})
+		ExpectApplied(ctx, client, testObject)
+		ExpectReconciled(ctx, controller, testObject)
+		ExpectMetricValue("operator_status_cold_observations_total", labels, count+1)
+		ExpectReconciled(ctx, controller, testObject)
+		ExpectMetricValue("operator_status_cold_observations_total", labels, count+1)
+	})
+
+	It("should observe the gap between reconciles of an object", func() {
+		fakeClock := clocktesting.NewFakeClock(time.Now())
+		controller = status.NewController[*TestObject](client, recorder, status.ControllerOpts{Clock: fakeClock})
+		testObject := test.Object(&TestObject{})
+		ExpectApplied(ctx, client, testObject)
+
+		ExpectReconciled(ctx, controller, testObject)
+		count, sum := MetricValue("operator_status_reconcile_gap_seconds", nil), MetricSum("operator_status_reconcile_gap_seconds", nil)
+
+		fakeClock.Step(time.Second * 5)
+		ExpectReconciled(ctx, controller, testObject)
+		ExpectMetricValue("operator_status_reconcile_gap_seconds", nil, count+1)
+		Expect(MetricSum("operator_status_reconcile_gap_seconds", nil)).To(BeNumerically("~", sum+5))
+	})
+
+	It("should snapshot the metrics emitted for the kind", func() {
+		testObject := test.Object(&TestObject{})
+		ExpectApplied(ctx, client, testObject)
+		ExpectReconciled(ctx, controller, testObject)
+		testObject.StatusConditions().SetTrue(ConditionTypeFoo)
+		ExpectApplied(ctx, client, testObject)
+		ExpectReconciled(ctx, controller, testObject)
+
+		snapshot := controller.MetricsSnapshot()
+		Expect(snapshot).To(ContainElement(And(
+			HaveField("Name", "operator_status_condition_count"),
+			HaveField("Labels", HaveKeyWithValue(status.MetricLabelName, testObject.Name)),
+			HaveField("Labels", HaveKeyWithValue(status.MetricLabelConditionType, ConditionTypeFoo)),
+			HaveField("Labels", HaveKeyWithValue(status.MetricLabelConditionStatus, string(metav1.ConditionTrue))),
+			HaveField("Value", BeEquivalentTo(1)),
+		)))
+		Expect(snapshot).ToNot(ContainElement(And(
+			HaveField("Name", "operator_status_condition_count"),
+			HaveField("Labels", HaveKeyWithValue(status.MetricLabelName, testObject.Name)),
+			HaveField("Labels", HaveKeyWithValue(status.MetricLabelConditionType, ConditionTypeFoo)),
+			HaveField("Labels", HaveKeyWithValue(status.MetricLabelConditionStatus, string(metav1.ConditionUnknown))),
+		)))
+		Expect(snapshot).To(ContainElement(And(
+			HaveField("Name", "operator_status_condition_transition_seconds_count"),
+			HaveField("Labels", HaveKeyWithValue(status.MetricLabelConditionType, ConditionTypeFoo)),
+			HaveField("Labels", HaveKeyWithValue(status.MetricLabelConditionStatus, string(metav1.ConditionUnknown))),
+			HaveField("Value", BeNumerically(">", 0)),
+		)))
+		Expect(snapshot).To(ContainElement(And(
+			HaveField("Name", "operator_status_condition_transitions_total"),
+			HaveField("Labels", HaveKeyWithValue(status.MetricLabelConditionType, ConditionTypeFoo)),
+			HaveField("Labels", HaveKeyWithValue(status.MetricLabelConditionStatus, string(metav1.ConditionTrue))),
+			HaveField("Value", BeNumerically(">", 0)),
+		)))
+	})
+
+	It("should label condition metrics with annotations", func() {
+		controller = status.NewController[*TestObject](client, recorder, status.ControllerOpts{AnnotationLabels: map[string]string{"example.com/team": "team"}})
+		testObject := test.Object(&TestObject{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{"example.com/team": "foo"}}})
+		ExpectApplied(ctx, client, testObject)
+		ExpectReconciled(ctx, controller, testObject)
+		ExpectMetricValue("operator_status_condition_count", lo.Assign(map[string]string{status.MetricLabelName: testObject.Name, "team": "foo"}, conditionLabels(ConditionTypeFoo, metav1.ConditionUnknown)), 1)
+
+		// Series with the previous annotation value are cleaned up
+		testObject.Annotations["example.com/team"] = "bar"
+		ExpectApplied(ctx, client, testObject)
+		ExpectReconciled(ctx, controller, testObject)
+		ExpectMetricNotFound("operator_status_condition_count", lo.Assign(map[string]string{status.MetricLabelName: testObject.Name, "team": "foo"}, conditionLabels(ConditionTypeFoo, metav1.ConditionUnknown)))
+		ExpectMetricValue("operator_status_condition_count", lo.Assign(map[string]string{status.MetricLabelName: testObject.Name, "team": "bar"}, conditionLabels(ConditionTypeFoo, metav1.ConditionUnknown)), 1)
+
+		ExpectDeleted(ctx, client, testObject)
+		ExpectReconciled(ctx, controller, testObject)
+		ExpectMetricNotFound("operator_status_condition_count", map[string]string{status.MetricLabelName: testObject.Name})
+	})
+	It("should label condition metrics with the context of the reconcile", func() {
+		shard := "shard-a"
+		controller = status.NewController[*TestObject](client, recorder, status.ControllerOpts{
+			ContextLabels:     func(context.Context) map[string]string { return map[string]string{"shard": shard} },
+			ContextLabelNames: []string{"shard"},
+		})
+		testObject := test.Object(&TestObject{})
+		ExpectApplied(ctx, client, testObject)
+		ExpectReconciled(ctx, controller, testObject)
+		ExpectMetricValue("operator_status_condition_count", lo.Assign(map[string]string{status.MetricLabelName: testObject.Name, "shard": "shard-a"}, conditionLabels(ConditionTypeFoo, metav1.ConditionUnknown)), 1)
+
+		// Labels are evaluated on each reconcile, and series with the previous labels are cleaned up
+		shard = "shard-b"
+		testObject.StatusConditions().SetTrue(ConditionTypeFoo)
+		ExpectApplied(ctx, client, testObject)
+		ExpectReconciled(ctx, controller, testObject)
+		ExpectMetricNotFound("operator_status_condition_count", map[string]string{status.MetricLabelName: testObject.Name, "shard": "shard-a"})
+		ExpectMetricValue("operator_status_condition_count", lo.Assign(map[string]string{status.MetricLabelName: testObject.Name, "shard": "shard-b"}, conditionLabels(ConditionTypeFoo, metav1.ConditionTrue)), 1)
+		ExpectMetricValue("operator_status_condition_transitions_total", map[string]string{"shard": "shard-b", status.MetricLabelConditionType: ConditionTypeFoo}, 1)
+	})
+
+	It("should emit the difference between spec and status fields", func() {
+		controller = status.NewController[*TestObject](client, recorder, status.ControllerOpts{SpecStatusFields: []status.SpecStatusField{
+			{Name: "replicas", SpecPath: []string{"spec", "replicas"}, StatusPath: []string{"status", "replicas"}},
+		}})
+		testObject := test.Object(&TestObject{Spec: TestSpec{Replicas: 5}, Status: TestStatus{Replicas: 3}})
+		ExpectApplied(ctx, client, testObject)
+		ExpectReconciled(ctx, controller, testObject)
+		ExpectMetricValue("operator_status_spec_status_diff", map[string]string{status.MetricLabelName: testObject.Name, status.MetricLabelField: "replicas"}, 2)
+
+		ExpectDeleted(ctx, client, testObject)
+		ExpectReconciled(ctx, controller, testObject)
+		ExpectMetricNotFound("operator_status_spec_status_diff", map[string]string{status.MetricLabelName: testObject.Name})
+	})
+
+	It("should report transitions from the checkpointed status after a restart", func() {
+		controller = status.NewController[*TestObject](client, recorder, status.ControllerOpts{Checkpoint: true})
+		testObject := test.Object(&TestObject{})
+		testObject.StatusConditions().SetFalse(ConditionTypeFoo, "reason", "message")
+		ExpectApplied(ctx, client, testObject)
+		ExpectReconciled(ctx, controller, testObject)
+		ExpectObject(ctx, client, testObject).To(HaveField("Annotations", HaveKeyWithValue(status.CheckpointAnnotationKey, `{"Bar":"Unknown","Foo":"False","Ready":"False"}`)))
+
+		// Simulate a restart, where the transition occurs while the controller isn't running
+		controller = status.NewController[*TestObject](client, recorder, status.ControllerOpts{Checkpoint: true})
+		testObject.StatusConditions().SetTrue(ConditionTypeFoo)
+		ExpectApplied(ctx, client, testObject)
+		ExpectReconciled(ctx, controller, testObject)
+		Expect(recorder.Events).To(Receive(Equal("Normal Foo Status condition transitioned, Type: Foo, Status: False -> True, Reason: Foo map[operatorpkg.k8s.aws/from-status:False operatorpkg.k8s.aws/reason:Foo operatorpkg.k8s.aws/to-status:True]")))
+		Expect(recorder.Events).To(Receive(Equal("Normal Ready Status condition transitioned, Type: Ready, Status: False -> Unknown, Reason: UnhealthyDependents, Message: Bar=Unknown map[operatorpkg.k8s.aws/from-status:False operatorpkg.k8s.aws/reason:UnhealthyDependents operatorpkg.k8s.aws/to-status:Unknown]")))
+		ExpectObject(ctx, client, testObject).To(HaveField("Annotations", HaveKeyWithValue(status.CheckpointAnnotationKey, `{"Bar":"Unknown","Foo":"True","Ready":"Unknown"}`)))
+	})
+	It("should checkpoint with the field manager", func() {
+		var fieldManagers []string
+		fieldManagerClient := interceptor.NewClient(client.(ctrlclient.WithWatch), interceptor.Funcs{
+			Patch: func(ctx context.Context, c ctrlclient.WithWatch, obj ctrlclient.Object, patch ctrlclient.Patch, opts ...ctrlclient.PatchOption) error {
+				patchOptions := &ctrlclient.PatchOptions{}
+				patchOptions.ApplyOptions(opts)
+				fieldManagers = append(fieldManagers, patchOptions.FieldManager)
+				return c.Patch(ctx, obj, patch, opts...)
+			},
+		})
+		controller = status.NewController[*TestObject](fieldManagerClient, recorder, status.ControllerOpts{Checkpoint: true})
+		testObject := test.Object(&TestObject{})
+		ExpectApplied(ctx, client, testObject)
+		ExpectReconciled(ctx, controller, testObject)
+		Expect(fieldManagers).To(ConsistOf(status.FieldManager))
+	})
+	It("should measure transitions that occur while no controller is running from the checkpoint", func() {
+		controller = status.NewController[*TestObject](client, recorder, status.ControllerOpts{Checkpoint: true})
+		testObject := test.Object(&TestObject{})
+		testObject.StatusConditions().SetFalse(ConditionTypeFoo, "reason", "message")
+		testObject.SetConditions(lo.Map(testObject.GetConditions(), func(condition status.Condition, _ int) status.Condition {
+			condition.LastTransitionTime = metav1.NewTime(time.Now().Add(-time.Hour))
+			return condition
+		}))
+		ExpectApplied(ctx, client, testObject)
+		ExpectReconciled(ctx, controller, testObject)
+		ExpectObject(ctx, client, testObject).To(HaveField("Annotations", HaveKey(status.CheckpointTransitionTimesAnnotationKey)))
+		count, sum := MetricValue("operator_status_condition_transition_seconds", conditionLabels(ConditionTypeFoo, metav1.ConditionFalse)), MetricSum("operator_status_condition_transition_seconds", conditionLabels(ConditionTypeFoo, metav1.ConditionFalse))
+
+		// Simulate a restart, where the transition occurs while the controller isn't running
+		controller = status.NewController[*TestObject](client, recorder, status.ControllerOpts{Checkpoint: true})
+		testObject.StatusConditions().SetTrue(ConditionTypeFoo)
+		ExpectApplied(ctx, client, testObject)
+		ExpectReconciled(ctx, controller, testObject)
+		ExpectMetricValue("operator_status_condition_transition_seconds", conditionLabels(ConditionTypeFoo, metav1.ConditionFalse), count+1)
+		Expect(MetricSum("operator_status_condition_transition_seconds", conditionLabels(ConditionTypeFoo, metav1.ConditionFalse))).To(BeNumerically("~", sum+time.Hour.Seconds(), 5))
+	})
+	It("should not report transitions after a restart without a checkpoint", func() {
+		testObject := test.Object(&TestObject{})
+		testObject.StatusConditions().SetFalse(ConditionTypeFoo, "reason", "message")
+		ExpectApplied(ctx, client, testObject)
+		ExpectReconciled(ctx, controller, testObject)
+		Expect(testObject.Annotations).ToNot(HaveKey(status.CheckpointAnnotationKey))
+
+		controller = status.NewController[*TestObject](client, recorder)
+		testObject.StatusConditions().SetTrue(ConditionTypeFoo)
+		ExpectApplied(ctx, client, testObject)
+		ExpectReconciled(ctx, controller, testObject)
+		Expect(recorder.Events).To(BeEmpty())
+	})
+
+	It("should emit termination duration", func() {
+		testObject := test.Object(&TestObject{ObjectMeta: metav1.ObjectMeta{Finalizers: []string{test.APIGroup + "/finalizer"}}})
+		ExpectApplied(ctx, client, testObject)
+		ExpectReconciled(ctx, controller, testObject)
+		count := MetricValue("operator_termination_duration_seconds", nil)
+
+		ExpectDeleted(ctx, client, testObject)
+		ExpectReconciled(ctx, controller, testObject)
+		Expect(MetricValue("operator_termination_duration_seconds", nil)).To(Equal(count))
+
+		testObject.Finalizers = nil
+		Expect(client.Update(ctx, testObject)).To(Succeed())
+		ExpectNotFound(ctx, client, testObject)
+		ExpectReconciled(ctx, controller, testObject)
+		ExpectMetricValue("operator_termination_duration_seconds", nil, count+1)
+	})
+	It("should label termination duration with the owner", func() {
+		controller = status.NewController[*TestObject](client, recorder, status.ControllerOpts{TerminationOwnerLabel: true})
+		testObject := test.Object(&TestObject{ObjectMeta: metav1.ObjectMeta{
+			Finalizers: []string{test.APIGroup + "/finalizer"},
+			OwnerReferences: []metav1.OwnerReference{
+				{APIVersion: "v1", Kind: "Owner", Name: "not-controller", UID: "not-controller"},
+				{APIVersion: "v1", Kind: "Owner", Name: "controller", UID: "controller", Controller: lo.ToPtr(true)},
+			},
+		}})
+		ExpectApplied(ctx, client, testObject)
+		ExpectReconciled(ctx, controller, testObject)
+		ExpectDeleted(ctx, client, testObject)
+		ExpectReconciled(ctx, controller, testObject)
+		testObject.Finalizers = nil
+		Expect(client.Update(ctx, testObject)).To(Succeed())
+		ExpectNotFound(ctx, client, testObject)
+		ExpectReconciled(ctx, controller, testObject)
+		ExpectMetricValue("operator_termination_duration_seconds", map[string]string{status.MetricLabelOwner: "Owner/controller"}, 1)
+	})
+	It("should emit the ratio of ready objects per owner", func() {
+		controller = status.NewController[*TestObject](client, recorder, status.ControllerOpts{OwnerReadyRatio: true})
+		owner := test.RandomName()
+		for i := 0; i < 3; i++ {
+			testObject := test.Object(&TestObject{ObjectMeta: metav1.ObjectMeta{
+				OwnerReferences: []metav1.OwnerReference{
+					{APIVersion: "v1", Kind: "Owner", Name: owner, UID: types.UID(owner), Controller: lo.ToPtr(true)},
+				},
+			}})
+			if i < 2 {
+				testObject.StatusConditions().SetTrue(ConditionTypeFoo)
+				testObject.StatusConditions().SetTrue(ConditionTypeBar)
 			}
+			ExpectApplied(ctx, client, testObject)
+			ExpectReconciled(ctx, controller, testObject)
 		}
-		if len(temp) == 0 {
-			return m
+		ExpectMetricValue("operator_status_owner_ready_ratio", map[string]string{status.MetricLabelOwner: "Owner/" + owner}, 2.0/3)
+	})
+	It("should share the owner ready ratio between controllers until they're unregistered", func() {
+		controller = status.NewController[*TestObject](client, recorder, status.ControllerOpts{OwnerReadyRatio: true})
+		another := status.NewController[*TestObject](client, recorder, status.ControllerOpts{OwnerReadyRatio: true})
+		owner := test.RandomName()
+		testObject := test.Object(&TestObject{ObjectMeta: metav1.ObjectMeta{
+			OwnerReferences: []metav1.OwnerReference{
+				{APIVersion: "v1", Kind: "Owner", Name: owner, UID: types.UID(owner), Controller: lo.ToPtr(true)},
+			},
+		}})
+		ExpectApplied(ctx, client, testObject)
+		ExpectReconciled(ctx, controller, testObject)
+		ExpectReconciled(ctx, another, testObject)
+		// Objects observed by both controllers are counted once
+		ExpectMetricValue("operator_status_owner_ready_ratio", map[string]string{status.MetricLabelOwner: "Owner/" + owner}, 0)
+
+		another.Unregister()
+		testObject.StatusConditions().SetTrue(ConditionTypeFoo)
+		testObject.StatusConditions().SetTrue(ConditionTypeBar)
+		ExpectApplied(ctx, client, testObject)
+		ExpectReconciled(ctx, controller, testObject)
+		ExpectMetricValue("operator_status_owner_ready_ratio", map[string]string{status.MetricLabelOwner: "Owner/" + owner}, 1)
+
+		controller.Unregister()
+		ExpectMetricNotFound("operator_status_owner_ready_ratio", map[string]string{status.MetricLabelOwner: "Owner/" + owner})
+	})
+
+	It("should label termination duration with how deletion was requested", func() {
+		gracePeriods := map[string]int64{}
+		// The fake client doesn't persist the grace period of deletions
+		gracePeriodClient := interceptor.NewClient(client.(ctrlclient.WithWatch), interceptor.Funcs{
+			Get: func(ctx context.Context, c ctrlclient.WithWatch, key ctrlclient.ObjectKey, obj ctrlclient.Object, opts ...ctrlclient.GetOption) error {
+				if err := c.Get(ctx, key, obj, opts...); err != nil {
+					return err
+				}
+				if gracePeriod, ok := gracePeriods[key.Name]; ok && obj.GetDeletionTimestamp() != nil {
+					obj.SetDeletionGracePeriodSeconds(lo.ToPtr(gracePeriod))
+				}
+				return nil
+			},
+		})
+		controller = status.NewController[*TestObject](gracePeriodClient, recorder, status.ControllerOpts{TerminationPropagationLabel: true})
+		terminations := func(propagation string) float64 {
+			return MetricValue("operator_termination_duration_seconds", map[string]string{status.MetricLabelKind: "TestObject", status.MetricLabelPropagation: propagation})
 		}
-	}
-	return nil
+		forced, background := terminations(status.TerminationPropagationForced), terminations(string(metav1.DeletePropagationBackground))
+
+		for _, gracePeriod := range []int64{0, 30} {
+			testObject := test.Object(&TestObject{ObjectMeta: metav1.ObjectMeta{Finalizers: []string{test.APIGroup + "/finalizer"}}})
+			gracePeriods[testObject.Name] = gracePeriod
+			ExpectApplied(ctx, client, testObject)
+			ExpectReconciled(ctx, controller, testObject)
+			ExpectDeleted(ctx, client, testObject)
+			ExpectReconciled(ctx, controller, testObject)
+			testObject.Finalizers = nil
+			Expect(client.Update(ctx, testObject)).To(Succeed())
+			ExpectNotFound(ctx, client, testObject)
+			ExpectReconciled(ctx, controller, testObject)
+		}
+		Expect(terminations(status.TerminationPropagationForced)).To(Equal(forced + 1))
+		Expect(terminations(string(metav1.DeletePropagationBackground))).To(Equal(background + 1))
+	})
+
+	It("should list the objects observed terminating", func() {
+		testObject := test.Object(&TestObject{ObjectMeta: metav1.ObjectMeta{Finalizers: []string{test.APIGroup + "/finalizer"}}})
+		ExpectApplied(ctx, client, testObject)
+		ExpectReconciled(ctx, controller, testObject)
+		Expect(controller.TerminatingObjects()).To(BeEmpty())
+
+		ExpectDeleted(ctx, client, testObject)
+		ExpectReconciled(ctx, controller, testObject)
+		Expect(client.Get(ctx, ctrlclient.ObjectKeyFromObject(testObject), testObject)).To(Succeed())
+		Expect(controller.TerminatingObjects()).To(Equal(map[types.NamespacedName]time.Time{
+			ctrlclient.ObjectKeyFromObject(testObject): testObject.DeletionTimestamp.Time,
+		}))
+
+		testObject.Finalizers = nil
+		Expect(client.Update(ctx, testObject)).To(Succeed())
+		ExpectNotFound(ctx, client, testObject)
+		ExpectReconciled(ctx, controller, testObject)
+		Expect(controller.TerminatingObjects()).To(BeEmpty())
+	})
+
+	It("should emit termination duration labeled with the termination reason", func() {
+		controller = status.NewController[*TestObject](client, recorder, status.ControllerOpts{TerminationReasonAnnotation: "example.com/termination-reason"})
+		terminations := func(reason string) float64 {
+			return MetricValue("operator_termination_duration_seconds", map[string]string{status.MetricLabelKind: "TestObject", status.MetricLabelTerminationReason: reason})
+		}
+		forced, unlabeled := terminations("forced"), terminations("")
+
+		for _, reason := range []string{"forced", ""} {
+			testObject := test.Object(&TestObject{ObjectMeta: metav1.ObjectMeta{Finalizers: []string{test.APIGroup + "/finalizer"}}})
+			ExpectApplied(ctx, client, testObject)
+			ExpectReconciled(ctx, controller, testObject)
+			if reason != "" {
+				testObject.Annotations = map[string]string{"example.com/termination-reason": reason}
+				ExpectApplied(ctx, client, testObject)
+			}
+			ExpectDeleted(ctx, client, testObject)
+			ExpectReconciled(ctx, controller, testObject)
+			testObject.Finalizers = nil
+			Expect(client.Update(ctx, testObject)).To(Succeed())
+			ExpectNotFound(ctx, client, testObject)
+			ExpectReconciled(ctx, controller, testObject)
+		}
+		Expect(terminations("forced")).To(Equal(forced + 1))
+		Expect(terminations("")).To(Equal(unlabeled + 1))
+	})
+
+	It("should emit staleness for conditions behind the object's generation", func() {
+		testObject := test.Object(&TestObject{ObjectMeta: metav1.ObjectMeta{Generation: 2}})
+		testObject.StatusConditions().Set(status.Condition{Type: ConditionTypeFoo, Status: metav1.ConditionTrue, Reason: "reason", ObservedGeneration: testObject.Generation})
+		testObject.StatusConditions().Set(status.Condition{Type: ConditionTypeBar, Status: metav1.ConditionTrue, Reason: "reason", ObservedGeneration: testObject.Generation - 1})
+		ExpectApplied(ctx, client, testObject)
+		ExpectReconciled(ctx, controller, testObject)
+
+		ExpectMetricNotFound("operator_status_condition_stale", map[string]string{status.MetricLabelName: testObject.Name, status.MetricLabelConditionType: ConditionTypeFoo})
+		ExpectMetricValue("operator_status_condition_stale", map[string]string{status.MetricLabelName: testObject.Name, status.MetricLabelConditionType: ConditionTypeBar}, 1)
+		// Conditions without an observedGeneration are never stale
+		ExpectMetricNotFound("operator_status_condition_stale", map[string]string{status.MetricLabelName: testObject.Name, status.MetricLabelConditionType: status.ConditionReady})
+
+		// Catch up Bar
+		testObject.StatusConditions().Set(status.Condition{Type: ConditionTypeBar, Status: metav1.ConditionTrue, Reason: "reason", ObservedGeneration: testObject.Generation})
+		ExpectApplied(ctx, client, testObject)
+		ExpectReconciled(ctx, controller, testObject)
+		ExpectMetricNotFound("operator_status_condition_stale", map[string]string{status.MetricLabelName: testObject.Name, status.MetricLabelConditionType: ConditionTypeBar})
+	})
+
+	It("should emit the observed generation lag of conditions", func() {
+		testObject := test.Object(&TestObject{ObjectMeta: metav1.ObjectMeta{Generation: 3}})
+		testObject.StatusConditions().Set(status.Condition{Type: ConditionTypeFoo, Status: metav1.ConditionTrue, Reason: "reason", ObservedGeneration: testObject.Generation})
+		testObject.StatusConditions().Set(status.Condition{Type: ConditionTypeBar, Status: metav1.ConditionTrue, Reason: "reason", ObservedGeneration: testObject.Generation - 2})
+		ExpectApplied(ctx, client, testObject)
+		ExpectReconciled(ctx, controller, testObject)
+
+		ExpectMetricValue("operator_status_condition_observed_generation_lag", map[string]string{status.MetricLabelName: testObject.Name, status.MetricLabelConditionType: ConditionTypeFoo}, 0)
+		ExpectMetricValue("operator_status_condition_observed_generation_lag", map[string]string{status.MetricLabelName: testObject.Name, status.MetricLabelConditionType: ConditionTypeBar}, 2)
+		// Conditions without an observedGeneration don't track the generation
+		ExpectMetricNotFound("operator_status_condition_observed_generation_lag", map[string]string{status.MetricLabelName: testObject.Name, status.MetricLabelConditionType: status.ConditionReady})
+
+		ExpectDeleted(ctx, client, testObject)
+		ExpectReconciled(ctx, controller, testObject)
+		ExpectMetricNotFound("operator_status_condition_observed_generation_lag", map[string]string{status.MetricLabelName: testObject.Name})
+	})
+
+	It("should count distinct objects by condition status", func() {
+		readyLabels := func(s metav1.ConditionStatus) map[string]string {
+			return lo.Assign(conditionLabels(status.ConditionReady, s), map[string]string{status.MetricLabelKind: "TestObject"})
+		}
+		objectsByCondition := func(s metav1.ConditionStatus) float64 {
+			return MetricValue("operator_status_objects_by_condition", readyLabels(s))
+		}
+		trueCount, falseCount, unknownCount := objectsByCondition(metav1.ConditionTrue), objectsByCondition(metav1.ConditionFalse), objectsByCondition(metav1.ConditionUnknown)
+
+		testObjects := []*TestObject{test.Object(&TestObject{}), test.Object(&TestObject{}), test.Object(&TestObject{}), test.Object(&TestObject{})}
+		testObjects[0].StatusConditions().SetTrue(ConditionTypeFoo)
+		testObjects[0].StatusConditions().SetTrue(ConditionTypeBar)
+		testObjects[1].StatusConditions().SetFalse(ConditionTypeFoo, "reason", "message")
+		testObjects[2].StatusConditions().SetFalse(ConditionTypeBar, "reason", "message")
+		for _, testObject := range testObjects {
+			ExpectApplied(ctx, client, testObject)
+			ExpectReconciled(ctx, controller, testObject)
+		}
+		Expect(objectsByCondition(metav1.ConditionTrue)).To(Equal(trueCount + 1))
+		Expect(objectsByCondition(metav1.ConditionFalse)).To(Equal(falseCount + 2))
+		Expect(objectsByCondition(metav1.ConditionUnknown)).To(Equal(unknownCount + 1))
+
+		// Reconciling again doesn't double count
+		ExpectReconciled(ctx, controller, testObjects[0])
+		Expect(objectsByCondition(metav1.ConditionTrue)).To(Equal(trueCount + 1))
+
+		// Transitions move the object between statuses
+		testObjects[1].StatusConditions().SetTrue(ConditionTypeFoo)
+		ExpectApplied(ctx, client, testObjects[1])
+		ExpectReconciled(ctx, controller, testObjects[1])
+		Expect(objectsByCondition(metav1.ConditionFalse)).To(Equal(falseCount + 1))
+		Expect(objectsByCondition(metav1.ConditionUnknown)).To(Equal(unknownCount + 2))
+
+		// Deleted objects are no longer counted
+		ExpectDeleted(ctx, client, testObjects[0])
+		ExpectReconciled(ctx, controller, testObjects[0])
+		Expect(objectsByCondition(metav1.ConditionTrue)).To(Equal(trueCount))
+	})
+
+	It("should send transitions to event sinks", func() {
+		sink := &fakeEventSink{}
+		controller = status.NewController[*TestObject](client, nil, status.ControllerOpts{EventSinks: []status.EventSink{sink}})
+		testObject := test.Object(&TestObject{})
+		testObject.StatusConditions()
+		ExpectApplied(ctx, client, testObject)
+		ExpectReconciled(ctx, controller, testObject)
+		Expect(sink.events).To(BeEmpty())
+
+		testObject.StatusConditions().SetFalse(ConditionTypeFoo, "reason", "message")
+		ExpectApplied(ctx, client, testObject)
+		ExpectReconciled(ctx, controller, testObject)
+		Expect(sink.events).To(ConsistOf(
+			And(
+				HaveField("Object.GetName()", testObject.Name),
+				HaveField("Previous.Type", ConditionTypeFoo),
+				HaveField("Previous.Status", metav1.ConditionUnknown),
+				HaveField("Current.Status", metav1.ConditionFalse),
+				HaveField("Current.Reason", "reason"),
+			),
+			And(
+				HaveField("Previous.Type", status.ConditionReady),
+				HaveField("Previous.Status", metav1.ConditionUnknown),
+				HaveField("Current.Status", metav1.ConditionFalse),
+			),
+		))
+	})
+
+	It("should only record transitions that hold for the hysteresis", func() {
+		fakeClock := clocktesting.NewFakeClock(time.Now())
+		controller = status.NewController[*TestObject](client, recorder, status.ControllerOpts{Clock: fakeClock, TransitionHysteresis: time.Minute})
+		testObject := test.Object(&TestObject{})
+		testObject.StatusConditions()
+		ExpectApplied(ctx, client, testObject)
+		ExpectReconciled(ctx, controller, testObject)
+
+		// A short lived flip is ignored
+		testObject.StatusConditions().SetTrue(ConditionTypeFoo)
+		ExpectApplied(ctx, client, testObject)
+		result := ExpectReconciled(ctx, controller, testObject)
+		Expect(result.RequeueAfter).To(BeNumerically("<=", time.Second*10))
+		testObject.StatusConditions().SetUnknown(ConditionTypeFoo)
+		ExpectApplied(ctx, client, testObject)
+		ExpectReconciled(ctx, controller, testObject)
+		Expect(recorder.Events).To(BeEmpty())
+
+		// A sustained flip is recorded once the hysteresis has passed
+		testObject.StatusConditions().SetTrue(ConditionTypeFoo)
+		ExpectApplied(ctx, client, testObject)
+		ExpectReconciled(ctx, controller, testObject)
+		Expect(recorder.Events).To(BeEmpty())
+		fakeClock.Step(time.Minute * 2)
+		ExpectReconciled(ctx, controller, testObject)
+		Expect(recorder.Events).To(Receive(Equal("Normal Foo Status condition transitioned, Type: Foo, Status: Unknown -> True, Reason: Foo map[operatorpkg.k8s.aws/from-status:Unknown operatorpkg.k8s.aws/reason:Foo operatorpkg.k8s.aws/to-status:True]")))
+		Expect(recorder.Events).To(BeEmpty())
+	})
+
+	It("should reconcile a type that supplies condition accessors", func() {
+		accessorController := status.NewControllerWithAccessors(client, recorder, status.ConditionAccessors[*TestAccessorObject]{
+			GetConditions: func(o *TestAccessorObject) []status.Condition { return o.Status.Health },
+			SetConditions: func(o *TestAccessorObject, conditions []status.Condition) { o.Status.Health = conditions },
+		})
+		testObject := test.Object(&TestAccessorObject{Status: TestAccessorStatus{Health: []status.Condition{
+			{Type: ConditionTypeFoo, Status: metav1.ConditionUnknown, Reason: "reason", LastTransitionTime: metav1.Now()},
+		}}})
+		ExpectApplied(ctx, client, testObject)
+		ExpectReconciled(ctx, accessorController, testObject)
+		ExpectMetricValue("operator_status_condition_count", lo.Assign(map[string]string{status.MetricLabelName: testObject.Name, status.MetricLabelKind: "TestAccessorObject"}, conditionLabels(ConditionTypeFoo, metav1.ConditionUnknown)), 1)
+
+		testObject.Status.Health = []status.Condition{{Type: ConditionTypeFoo, Status: metav1.ConditionTrue, Reason: "reason", LastTransitionTime: metav1.Now()}}
+		ExpectApplied(ctx, client, testObject)
+		ExpectReconciled(ctx, accessorController, testObject)
+		Expect(recorder.Events).To(Receive(Equal("Normal Foo Status condition transitioned, Type: Foo, Status: Unknown -> True, Reason: reason map[operatorpkg.k8s.aws/from-status:Unknown operatorpkg.k8s.aws/reason:reason operatorpkg.k8s.aws/to-status:True]")))
+		ExpectMetricNotFound("operator_status_condition_count", lo.Assign(map[string]string{status.MetricLabelName: testObject.Name, status.MetricLabelKind: "TestAccessorObject"}, conditionLabels(ConditionTypeFoo, metav1.ConditionUnknown)))
+		ExpectMetricValue("operator_status_condition_count", lo.Assign(map[string]string{status.MetricLabelName: testObject.Name, status.MetricLabelKind: "TestAccessorObject"}, conditionLabels(ConditionTypeFoo, metav1.ConditionTrue)), 1)
+	})
+
+	It("should mark the metrics of externally observed objects", func() {
+		fakeClock := clocktesting.NewFakeClock(time.Now().Truncate(time.Second))
+		externalController := status.NewControllerWithAccessors(client, recorder, status.ConditionAccessors[*TestAccessorObject]{
+			GetConditions: func(o *TestAccessorObject) []status.Condition { return o.Status.Health },
+			SetConditions: func(o *TestAccessorObject, conditions []status.Condition) { o.Status.Health = conditions },
+		}, status.ControllerOpts{External: true, Clock: fakeClock})
+		testObject := test.Object(&TestAccessorObject{Status: TestAccessorStatus{Health: []status.Condition{
+			{Type: ConditionTypeFoo, Status: metav1.ConditionFalse, Reason: "reason", LastTransitionTime: metav1.NewTime(fakeClock.Now().Add(-time.Minute))},
+		}}})
+		ExpectApplied(ctx, client, testObject)
+		ExpectReconciled(ctx, externalController, testObject)
+		ExpectMetricValue("operator_status_condition_count", lo.Assign(map[string]string{status.MetricLabelName: testObject.Name, status.MetricLabelExternal: "true"}, conditionLabels(ConditionTypeFoo, metav1.ConditionFalse)), 1)
+		ExpectMetricValue("operator_status_condition_current_status_seconds", lo.Assign(map[string]string{status.MetricLabelName: testObject.Name, status.MetricLabelExternal: "true"}, conditionLabels(ConditionTypeFoo, metav1.ConditionFalse)), time.Minute.Seconds())
+	})
+
+	It("should trace each reconcile with the transitions detected", func() {
+		exporter := tracetest.NewInMemoryExporter()
+		controller = status.NewController[*TestObject](client, recorder, status.ControllerOpts{TracerProvider: sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))})
+		testObject := test.Object(&TestObject{})
+		testObject.StatusConditions()
+		ExpectApplied(ctx, client, testObject)
+		ExpectReconciled(ctx, controller, testObject)
+
+		testObject.StatusConditions().SetTrue(ConditionTypeFoo)
+		ExpectApplied(ctx, client, testObject)
+		ExpectReconciled(ctx, controller, testObject)
+
+		spans := exporter.GetSpans()
+		Expect(spans).To(HaveLen(2))
+		for i, transitions := range []int{0, 1} {
+			Expect(spans[i].Name).To(Equal("operatorpkg.testobject.status.reconcile"))
+			Expect(spans[i].Attributes).To(ConsistOf(
+				attribute.String(status.SpanAttributeNamespace, testObject.Namespace),
+				attribute.String(status.SpanAttributeName, testObject.Name),
+				attribute.Int(status.SpanAttributeTransitions, transitions),
+			))
+		}
+	})
+
+	It("should emit metrics with the configured namespace", func() {
+		controller = status.NewController[*TestObject](client, recorder, status.ControllerOpts{MetricNamespace: "karpenter"})
+		testObject := test.Object(&TestObject{})
+		testObject.StatusConditions()
+		ExpectApplied(ctx, client, testObject)
+		ExpectReconciled(ctx, controller, testObject)
+		ExpectMetricValue("karpenter_status_condition_count", lo.Assign(map[string]string{status.MetricLabelName: testObject.Name}, conditionLabels(ConditionTypeFoo, metav1.ConditionUnknown)), 1)
+		ExpectMetricNotFound("operator_status_condition_count", map[string]string{status.MetricLabelName: testObject.Name})
+	})
+
+	It("should emit metrics of controllers with different subsystems under distinct names", func() {
+		nodePoolController := status.NewController[*TestObject](client, recorder, status.ControllerOpts{MetricSubsystem: "nodepool"})
+		nodeClaimController := status.NewController[*TestObject](client, recorder, status.ControllerOpts{MetricSubsystem: "nodeclaim"})
+		nodePool, nodeClaim := test.Object(&TestObject{}), test.Object(&TestObject{})
+		nodePool.StatusConditions()
+		nodeClaim.StatusConditions()
+		ExpectApplied(ctx, client, nodePool, nodeClaim)
+		ExpectReconciled(ctx, nodePoolController, nodePool)
+		ExpectReconciled(ctx, nodeClaimController, nodeClaim)
+		ExpectMetricValue("operator_nodepool_status_condition_count", map[string]string{status.MetricLabelName: nodePool.Name}, 1)
+		ExpectMetricNotFound("operator_nodepool_status_condition_count", map[string]string{status.MetricLabelName: nodeClaim.Name})
+		ExpectMetricValue("operator_nodeclaim_status_condition_count", map[string]string{status.MetricLabelName: nodeClaim.Name}, 1)
+		ExpectMetricNotFound("operator_nodeclaim_status_condition_count", map[string]string{status.MetricLabelName: nodePool.Name})
+		ExpectMetricNotFound("operator_status_condition_count", map[string]string{status.MetricLabelName: nodePool.Name})
+		Expect(nodePoolController.MetricsSnapshot()).To(ContainElement(HaveField("Name", "operator_nodepool_status_condition_count")))
+	})
+
+	It("should aggregate condition metrics without per-object labels", func() {
+		start := time.Now().Truncate(time.Second)
+		fakeClock := clocktesting.NewFakeClock(start)
+		controller = status.NewController[*TestObject](client, recorder, status.ControllerOpts{Clock: fakeClock, ReducedCardinality: true})
+		objectSeries := func(name string) []*prometheus.Metric {
+			family, found := lo.Find(lo.Must(metrics.Registry.Gather()), func(family *prometheus.MetricFamily) bool { return family.GetName() == name })
+			Expect(found).To(BeTrue())
+			return lo.Filter(family.Metric, func(m *prometheus.Metric, _ int) bool {
+				labels := lo.SliceToMap(m.Label, func(label *prometheus.LabelPair) (string, string) { return label.GetName(), label.GetValue() })
+				_, ok := labels[status.MetricLabelName]
+				return !ok && labels[status.MetricLabelKind] == "TestObject" && labels[status.MetricLabelConditionType] == ConditionTypeFoo && labels[status.MetricLabelConditionStatus] == string(metav1.ConditionFalse)
+			})
+		}
+		for _, offset := range []time.Duration{time.Minute, 2 * time.Minute} {
+			testObject := test.Object(&TestObject{Status: TestStatus{Conditions: []status.Condition{
+				{Type: ConditionTypeFoo, Status: metav1.ConditionFalse, Reason: "reason", LastTransitionTime: metav1.NewTime(start.Add(-offset))},
+			}}})
+			ExpectApplied(ctx, client, testObject)
+			ExpectReconciled(ctx, controller, testObject)
+			ExpectMetricNotFound("operator_status_condition_count", map[string]string{status.MetricLabelName: testObject.Name})
+			ExpectMetricNotFound("operator_status_condition_current_status_seconds", map[string]string{status.MetricLabelName: testObject.Name})
+		}
+		Expect(objectSeries("operator_status_condition_count")).To(ConsistOf(HaveField("Gauge.Value", lo.ToPtr(2.0))))
+		Expect(objectSeries("operator_status_condition_current_status_seconds")).To(ConsistOf(HaveField("Gauge.Value", lo.ToPtr(120.0))))
+	})
+
+	It("should reconcile a type whose conditions are adapted by a codec", func() {
+		codecController := status.NewControllerWithCodec[*TestCodecObject](client, recorder, TestCheckCodec{})
+		testObject := test.Object(&TestCodecObject{Status: TestCodecStatus{Checks: []TestCheck{{Name: ConditionTypeFoo, State: TestCheckStatePending}}}})
+		ExpectApplied(ctx, client, testObject)
+		ExpectReconciled(ctx, codecController, testObject)
+		ExpectMetricValue("operator_status_condition_count", lo.Assign(map[string]string{status.MetricLabelName: testObject.Name, status.MetricLabelKind: "TestCodecObject"}, conditionLabels(ConditionTypeFoo, metav1.ConditionUnknown)), 1)
+
+		testObject.Status.Checks = []TestCheck{{Name: ConditionTypeFoo, State: TestCheckStateFailing}}
+		ExpectApplied(ctx, client, testObject)
+		ExpectReconciled(ctx, codecController, testObject)
+		Expect(recorder.Events).To(Receive(Equal("Normal Foo Status condition transitioned, Type: Foo, Status: Unknown -> False, Reason: Foo map[operatorpkg.k8s.aws/from-status:Unknown operatorpkg.k8s.aws/reason:Foo operatorpkg.k8s.aws/to-status:False]")))
+		ExpectMetricNotFound("operator_status_condition_count", lo.Assign(map[string]string{status.MetricLabelName: testObject.Name, status.MetricLabelKind: "TestCodecObject"}, conditionLabels(ConditionTypeFoo, metav1.ConditionUnknown)))
+		ExpectMetricValue("operator_status_condition_count", lo.Assign(map[string]string{status.MetricLabelName: testObject.Name, status.MetricLabelKind: "TestCodecObject"}, conditionLabels(ConditionTypeFoo, metav1.ConditionFalse)), 1)
+	})
+
+	It("should count reconciles skipped by a predicate", func() {
+		controller = status.NewController[*TestObject](client, recorder, status.ControllerOpts{SkipPredicates: []status.SkipPredicate{{
+			Reason: status.SkipReasonPaused,
+			Skip:   func(o ctrlclient.Object) bool { return o.GetAnnotations()["example.com/paused"] == "true" },
+		}}})
+		skipped := func() float64 {
+			return MetricValue("operator_status_reconciles_skipped_total", map[string]string{status.MetricLabelSkipReason: status.SkipReasonPaused})
+		}
+		count := skipped()
+
+		testObject := test.Object(&TestObject{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{"example.com/paused": "true"}}})
+		testObject.StatusConditions()
+		ExpectApplied(ctx, client, testObject)
+		ExpectReconciled(ctx, controller, testObject)
+		Expect(skipped()).To(Equal(count + 1))
+		ExpectMetricNotFound("operator_status_condition_count", map[string]string{status.MetricLabelName: testObject.Name})
+
+		testObject.Annotations = nil
+		ExpectApplied(ctx, client, testObject)
+		ExpectReconciled(ctx, controller, testObject)
+		Expect(skipped()).To(Equal(count + 1))
+		ExpectMetricValue("operator_status_condition_count", map[string]string{status.MetricLabelName: testObject.Name}, 1)
+	})
+
+	It("should clear the metrics of paused objects without recording transitions", func() {
+		testObject := test.Object(&TestObject{})
+		testObject.StatusConditions()
+		ExpectApplied(ctx, client, testObject)
+		ExpectReconciled(ctx, controller, testObject)
+		ExpectMetricValue("operator_status_condition_count", map[string]string{status.MetricLabelName: testObject.Name}, 1)
+
+		testObject.Annotations = map[string]string{status.PauseAnnotation: "true"}
+		testObject.StatusConditions().SetTrue(ConditionTypeFoo)
+		ExpectApplied(ctx, client, testObject)
+		ExpectReconciled(ctx, controller, testObject)
+		ExpectMetricNotFound("operator_status_condition_count", map[string]string{status.MetricLabelName: testObject.Name})
+		Expect(recorder.Events).To(BeEmpty())
+
+		testObject.Annotations = nil
+		ExpectApplied(ctx, client, testObject)
+		ExpectReconciled(ctx, controller, testObject)
+		ExpectMetricValue("operator_status_condition_count", lo.Assign(map[string]string{status.MetricLabelName: testObject.Name}, conditionLabels(ConditionTypeFoo, metav1.ConditionTrue)), 1)
+		Expect(recorder.Events).To(BeEmpty())
+	})
+
+	It("should pause objects with the configured annotation", func() {
+		controller = status.NewController[*TestObject](client, recorder, status.ControllerOpts{PauseAnnotation: "example.com/paused"})
+		testObject := test.Object(&TestObject{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{"example.com/paused": "true"}}})
+		testObject.StatusConditions()
+		ExpectApplied(ctx, client, testObject)
+		ExpectReconciled(ctx, controller, testObject)
+		ExpectMetricNotFound("operator_status_condition_count", map[string]string{status.MetricLabelName: testObject.Name})
+	})
+
+	It("should only observe objects in the configured namespace and matching the selector", func() {
+		controller = status.NewController[*TestObject](client, recorder, status.ControllerOpts{
+			Namespace: test.Namespace.Name,
+			Selector:  labels.SelectorFromSet(labels.Set{"example.com/team": "a"}),
+		})
+		matching := test.Object(&TestObject{ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"example.com/team": "a"}}})
+		unlabeled := test.Object(&TestObject{})
+		otherNamespace := test.Object(&TestObject{ObjectMeta: metav1.ObjectMeta{Namespace: "other", Labels: map[string]string{"example.com/team": "a"}}})
+		for _, testObject := range []*TestObject{matching, unlabeled, otherNamespace} {
+			testObject.StatusConditions()
+			ExpectApplied(ctx, client, testObject)
+			ExpectReconciled(ctx, controller, testObject)
+		}
+		ExpectMetricValue("operator_status_condition_count", map[string]string{status.MetricLabelName: matching.Name}, 1)
+		ExpectMetricNotFound("operator_status_condition_count", map[string]string{status.MetricLabelName: unlabeled.Name})
+		ExpectMetricNotFound("operator_status_condition_count", map[string]string{status.MetricLabelName: otherNamespace.Name})
+		Expect(status.EventFilter(controller).Create(event.CreateEvent{Object: matching})).To(BeTrue())
+		Expect(status.EventFilter(controller).Create(event.CreateEvent{Object: unlabeled})).To(BeFalse())
+		Expect(status.EventFilter(controller).Create(event.CreateEvent{Object: otherNamespace})).To(BeFalse())
+
+		// Objects that leave the selector are forgotten
+		matching.Labels = nil
+		ExpectApplied(ctx, client, matching)
+		ExpectReconciled(ctx, controller, matching)
+		ExpectMetricNotFound("operator_status_condition_count", map[string]string{status.MetricLabelName: matching.Name})
+	})
+
+	It("should format transition events with the configured message func", func() {
+		controller = status.NewController[*TestObject](client, recorder, status.ControllerOpts{EventMessageFunc: func(o ctrlclient.Object, previous, current status.Condition) string {
+			return fmt.Sprintf("%s %s: %s => %s", o.GetName(), current.Type, previous.Status, current.Status)
+		}})
+		testObject := test.Object(&TestObject{})
+		testObject.StatusConditions()
+		ExpectApplied(ctx, client, testObject)
+		ExpectReconciled(ctx, controller, testObject)
+		testObject.StatusConditions().SetTrue(ConditionTypeFoo)
+		testObject.StatusConditions().SetTrue(ConditionTypeBar)
+		ExpectApplied(ctx, client, testObject)
+		ExpectReconciled(ctx, controller, testObject)
+		ExpectEvent(recorder, ConditionTypeBar, testObject.Name, "Bar: Unknown => True")
+		ExpectEvent(recorder, ConditionTypeFoo, testObject.Name, "Foo: Unknown => True")
+		ExpectEvent(recorder, status.ConditionReady, testObject.Name, "Ready: Unknown => True")
+		ExpectNoEvents(recorder)
+	})
+
+	It("should observe objects served by an aggregated API server", func() {
+		Expect(object.GVK(object.New[*TestAggregatedObject]())).To(Equal(schema.GroupVersionKind{Group: AggregatedAPIGroup, Version: "v1beta1", Kind: "TestAggregatedObject"}))
+		client = fake.NewClientBuilder().WithScheme(scheme.Scheme).WithStatusSubresource(&TestAggregatedObject{}).Build()
+		aggregatedController := status.NewController[*TestAggregatedObject](client, recorder)
+		testObject := test.Object(&TestAggregatedObject{})
+		testObject.StatusConditions()
+		ExpectApplied(ctx, client, testObject)
+		ExpectReconciled(ctx, aggregatedController, testObject)
+
+		changed, err := status.UpdateStatusIfChanged(ctx, client, testObject, testObject.StatusConditions())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(changed).To(BeFalse())
+		desired := testObject.DeepCopyObject().(*TestAggregatedObject)
+		desired.StatusConditions().SetTrue(ConditionTypeFoo)
+		changed, err = status.UpdateStatusIfChanged(ctx, client, testObject, desired.StatusConditions())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(changed).To(BeTrue())
+
+		ExpectReconciled(ctx, aggregatedController, testObject)
+		ExpectEvent(recorder, ConditionTypeFoo, "Unknown -> True")
+		ExpectMetricValue("operator_status_condition_count", lo.Assign(map[string]string{status.MetricLabelName: testObject.Name, status.MetricLabelGroup: AggregatedAPIGroup}, conditionLabels(ConditionTypeFoo, metav1.ConditionTrue)), 1)
+	})
+
+	It("should clean up the metrics of unknown condition types", func() {
+		testObject := test.Object(&TestObject{})
+		testObject.StatusConditions().Set(status.Condition{Type: "Retired", Status: metav1.ConditionTrue, Reason: "Retired"})
+		ExpectApplied(ctx, client, testObject)
+		ExpectReconciled(ctx, controller, testObject)
+		ExpectMetricValue("operator_status_condition_count", lo.Assign(map[string]string{status.MetricLabelName: testObject.Name}, conditionLabels("Retired", metav1.ConditionTrue)), 1)
+
+		controller = status.NewController[*TestObject](client, recorder, status.ControllerOpts{KnownConditionTypes: []string{ConditionTypeBaz}})
+		ExpectReconciled(ctx, controller, testObject)
+		ExpectMetricNotFound("operator_status_condition_count", lo.Assign(map[string]string{status.MetricLabelName: testObject.Name}, conditionLabels("Retired", metav1.ConditionTrue)))
+		ExpectMetricNotFound("operator_status_condition_current_status_seconds", lo.Assign(map[string]string{status.MetricLabelName: testObject.Name}, conditionLabels("Retired", metav1.ConditionTrue)))
+		ExpectMetricValue("operator_status_condition_count", lo.Assign(map[string]string{status.MetricLabelName: testObject.Name}, conditionLabels(ConditionTypeFoo, metav1.ConditionUnknown)), 1)
+		stored := &TestObject{}
+		Expect(client.Get(ctx, ctrlclient.ObjectKeyFromObject(testObject), stored)).To(Succeed())
+		Expect(stored.Status.Conditions).To(ContainElement(HaveField("Type", "Retired")))
+	})
+
+	It("should prune unknown condition types from the object", func() {
+		client = fake.NewClientBuilder().WithScheme(scheme.Scheme).WithStatusSubresource(&TestObject{}).Build()
+		controller = status.NewController[*TestObject](client, recorder, status.ControllerOpts{KnownConditionTypes: []string{ConditionTypeBaz}, PruneUnknownConditions: true})
+		testObject := test.Object(&TestObject{})
+		testObject.StatusConditions().Set(status.Condition{Type: "Retired", Status: metav1.ConditionTrue, Reason: "Retired"})
+		testObject.StatusConditions().SetTrue(ConditionTypeBaz)
+		ExpectApplied(ctx, client, testObject)
+		ExpectReconciled(ctx, controller, testObject)
+		ExpectMetricNotFound("operator_status_condition_count", lo.Assign(map[string]string{status.MetricLabelName: testObject.Name}, conditionLabels("Retired", metav1.ConditionTrue)))
+		stored := &TestObject{}
+		Expect(client.Get(ctx, ctrlclient.ObjectKeyFromObject(testObject), stored)).To(Succeed())
+		Expect(stored.Status.Conditions).To(ConsistOf(
+			HaveField("Type", status.ConditionReady),
+			HaveField("Type", ConditionTypeFoo),
+			HaveField("Type", ConditionTypeBar),
+			HaveField("Type", ConditionTypeBaz),
+		))
+	})
+
+	It("should requeue after the configured interval", func() {
+		testObject := test.Object(&TestObject{})
+		testObject.StatusConditions()
+		ExpectApplied(ctx, client, testObject)
+		Expect(ExpectReconciled(ctx, controller, testObject).RequeueAfter).To(Equal(time.Second * 10))
+		controller = status.NewController[*TestObject](client, recorder, status.ControllerOpts{RequeueInterval: time.Minute})
+		Expect(ExpectReconciled(ctx, controller, testObject).RequeueAfter).To(Equal(time.Minute))
+		Expect(func() {
+			status.NewController[*TestObject](client, recorder, status.ControllerOpts{RequeueInterval: -time.Minute})
+		}).To(Panic())
+	})
+
+	It("should register with the configured max concurrent reconciles", func() {
+		Expect(status.ControllerOptions(controller).MaxConcurrentReconciles).To(Equal(10))
+		controller = status.NewController[*TestObject](client, recorder, status.ControllerOpts{MaxConcurrentReconciles: 50})
+		Expect(status.ControllerOptions(controller).MaxConcurrentReconciles).To(Equal(50))
+	})
+
+	It("should reconcile objects concurrently", func() {
+		entered := make(chan struct{}, 2)
+		overlapping := make(chan struct{})
+		baseClient := fake.NewClientBuilder().WithScheme(scheme.Scheme).Build()
+		// Each read waits for the other reconcile to start, which never happens if reconciles are serialized
+		overlappingClient := interceptor.NewClient(baseClient, interceptor.Funcs{
+			Get: func(ctx context.Context, c ctrlclient.WithWatch, key ctrlclient.ObjectKey, obj ctrlclient.Object, opts ...ctrlclient.GetOption) error {
+				entered <- struct{}{}
+				select {
+				case <-overlapping:
+				case <-time.After(FastTimeout):
+					return fmt.Errorf("reconciles of %s didn't overlap", key)
+				}
+				return c.Get(ctx, key, obj, opts...)
+			},
+		})
+		controller = status.NewController[*TestObject](overlappingClient, recorder)
+
+		done := make(chan struct{})
+		for _, testObject := range []*TestObject{test.Object(&TestObject{}), test.Object(&TestObject{})} {
+			ExpectApplied(ctx, baseClient, testObject)
+			go func() {
+				defer GinkgoRecover()
+				ExpectReconciled(ctx, controller, testObject)
+				done <- struct{}{}
+			}()
+		}
+		Eventually(entered).Should(Receive())
+		Eventually(entered).Should(Receive())
+		close(overlapping)
+		Eventually(done).Should(Receive())
+		Eventually(done).Should(Receive())
+	})
+
+	It("should register with the configured rate limiter", func() {
+		Expect(status.ControllerOptions(controller).RateLimiter).To(BeNil())
+		rateLimiter := workqueue.NewItemExponentialFailureRateLimiter(time.Second, time.Minute)
+		controller = status.NewController[*TestObject](client, recorder, status.ControllerOpts{RateLimiter: rateLimiter})
+		Expect(status.ControllerOptions(controller).RateLimiter).To(BeIdenticalTo(rateLimiter))
+	})
+
+	It("should filter updates with the configured change predicate", func() {
+		testObject := test.Object(&TestObject{})
+		annotated := testObject.DeepCopy()
+		annotated.Annotations = map[string]string{"example.com/watched": "true"}
+		Expect(status.EventFilter(controller).Update(event.UpdateEvent{ObjectOld: testObject, ObjectNew: testObject})).To(BeTrue())
+
+		controller = status.NewController[*TestObject](client, recorder, status.ControllerOpts{ChangePredicate: func(old, new ctrlclient.Object) bool {
+			return old.GetAnnotations()["example.com/watched"] != new.GetAnnotations()["example.com/watched"]
+		}})
+		Expect(status.EventFilter(controller).Update(event.UpdateEvent{ObjectOld: testObject, ObjectNew: annotated})).To(BeTrue())
+		Expect(status.EventFilter(controller).Update(event.UpdateEvent{ObjectOld: testObject, ObjectNew: testObject})).To(BeFalse())
+		Expect(status.EventFilter(controller).Create(event.CreateEvent{Object: testObject})).To(BeTrue())
+	})
+
+	It("should suppress current status seconds for young objects", func() {
+		fakeClock := clocktesting.NewFakeClock(time.Now().Truncate(time.Second))
+		controller = status.NewController[*TestObject](client, recorder, status.ControllerOpts{Clock: fakeClock, MinObjectAgeForMetrics: time.Minute})
+		testObject := test.Object(&TestObject{ObjectMeta: metav1.ObjectMeta{CreationTimestamp: metav1.NewTime(fakeClock.Now())}})
+		testObject.StatusConditions()
+		testObject.Status.Conditions = lo.Map(testObject.Status.Conditions, func(condition status.Condition, _ int) status.Condition {
+			condition.LastTransitionTime = metav1.NewTime(fakeClock.Now())
+			return condition
+		})
+		ExpectApplied(ctx, client, testObject)
+		ExpectReconciled(ctx, controller, testObject)
+		ExpectMetricNotFound("operator_status_condition_current_status_seconds", map[string]string{status.MetricLabelName: testObject.Name})
+		// Counts are still emitted
+		ExpectMetricValue("operator_status_condition_count", map[string]string{status.MetricLabelName: testObject.Name}, 1)
+
+		fakeClock.Step(time.Minute)
+		ExpectReconciled(ctx, controller, testObject)
+		ExpectMetricValue("operator_status_condition_current_status_seconds", lo.Assign(map[string]string{status.MetricLabelName: testObject.Name}, conditionLabels(ConditionTypeFoo, metav1.ConditionUnknown)), time.Minute.Seconds())
+	})
+
+	It("should garbage collect the series of objects that aren't reconciled within the TTL", func() {
+		fakeClock := clocktesting.NewFakeClock(time.Now())
+		controller = status.NewController[*TestObject](client, recorder, status.ControllerOpts{Clock: fakeClock, MetricTTL: time.Minute})
+		sweepCtx, cancel := context.WithCancel(ctx)
+		DeferCleanup(cancel)
+		go func() {
+			defer GinkgoRecover()
+			Expect(status.Sweep(sweepCtx, controller)).To(Succeed())
+		}()
+		Eventually(fakeClock.HasWaiters).Should(BeTrue())
+
+		stale, refreshed := test.Object(&TestObject{}), test.Object(&TestObject{})
+		stale.StatusConditions()
+		refreshed.StatusConditions()
+		ExpectApplied(ctx, client, stale, refreshed)
+		ExpectReconciled(ctx, controller, stale)
+		ExpectReconciled(ctx, controller, refreshed)
+
+		fakeClock.Step(time.Second * 30)
+		ExpectReconciled(ctx, controller, refreshed)
+		fakeClock.Step(time.Second * 30)
+		Eventually(func() *prometheus.Metric {
+			return FindMetric("operator_status_condition_count", map[string]string{status.MetricLabelName: stale.Name})
+		}).Should(BeNil())
+		ExpectMetricValue("operator_status_condition_count", map[string]string{status.MetricLabelName: refreshed.Name}, 1)
+	})
+
+	It("should label condition metrics with the version of the kind", func() {
+		v1Controller := status.NewController[*TestObjectV1](client, recorder)
+		v1alpha1Object := test.Object(&TestObject{})
+		v1Object := &TestObjectV1{TestObject: *test.Object(&TestObject{})}
+		v1alpha1Object.StatusConditions().SetTrue(ConditionTypeFoo)
+		v1Object.StatusConditions().SetFalse(ConditionTypeFoo, "reason", "message")
+		ExpectApplied(ctx, client, v1alpha1Object, v1Object)
+		ExpectReconciled(ctx, controller, v1alpha1Object)
+		ExpectReconciled(ctx, v1Controller, v1Object)
+
+		ExpectMetricValue("operator_status_condition_count", lo.Assign(map[string]string{status.MetricLabelName: v1alpha1Object.Name, status.MetricLabelVersion: "v1alpha1"}, conditionLabels(ConditionTypeFoo, metav1.ConditionTrue)), 1)
+		ExpectMetricValue("operator_status_condition_count", lo.Assign(map[string]string{status.MetricLabelName: v1Object.Name, status.MetricLabelVersion: "v1"}, conditionLabels(ConditionTypeFoo, metav1.ConditionFalse)), 1)
+		Expect(MetricValue("operator_status_objects_by_condition", lo.Assign(map[string]string{status.MetricLabelVersion: "v1"}, conditionLabels(ConditionTypeFoo, metav1.ConditionFalse)))).To(BeNumerically(">", 0))
+	})
+
+	It("should emit condition counts with a configured value", func() {
+		controller = status.NewController[*TestObject](client, recorder, status.ControllerOpts{ConditionCountValue: func(o ctrlclient.Object, condition status.Condition) float64 {
+			if condition.Type == ConditionTypeFoo && condition.IsFalse() {
+				return float64(o.(*TestObject).Status.Replicas)
+			}
+			return 1
+		}})
+		testObject := test.Object(&TestObject{Status: TestStatus{Replicas: 3}})
+		testObject.StatusConditions().SetFalse(ConditionTypeFoo, "reason", "message")
+		ExpectApplied(ctx, client, testObject)
+		ExpectReconciled(ctx, controller, testObject)
+		ExpectMetricValue("operator_status_condition_count", lo.Assign(map[string]string{status.MetricLabelName: testObject.Name}, conditionLabels(ConditionTypeFoo, metav1.ConditionFalse)), 3)
+		ExpectMetricValue("operator_status_condition_count", lo.Assign(map[string]string{status.MetricLabelName: testObject.Name}, conditionLabels(ConditionTypeBar, metav1.ConditionUnknown)), 1)
+	})
+
+	It("should ignore conditions owned by other field managers", func() {
+		controller = status.NewController[*TestObject](client, recorder, status.ControllerOpts{ConditionFieldManager: "foo-controller"})
+		testObject := test.Object(&TestObject{ObjectMeta: metav1.ObjectMeta{ManagedFields: []metav1.ManagedFieldsEntry{
+			{
+				Manager:     "foo-controller",
+				Operation:   metav1.ManagedFieldsOperationUpdate,
+				Subresource: "status",
+				FieldsType:  "FieldsV1",
+				FieldsV1:    &metav1.FieldsV1{Raw: []byte(`{"f:status":{"f:conditions":{".":{},"k:{\"type\":\"Foo\"}":{".":{},"f:status":{},"f:type":{}}}}}`)},
+			},
+			{
+				Manager:     "bar-controller",
+				Operation:   metav1.ManagedFieldsOperationUpdate,
+				Subresource: "status",
+				FieldsType:  "FieldsV1",
+				FieldsV1:    &metav1.FieldsV1{Raw: []byte(`{"f:status":{"f:conditions":{"k:{\"type\":\"Bar\"}":{".":{},"f:status":{},"f:type":{}}}}}`)},
+			},
+		}}})
+		testObject.StatusConditions()
+		ExpectApplied(ctx, client, testObject)
+		ExpectReconciled(ctx, controller, testObject)
+		ExpectMetricValue("operator_status_condition_count", map[string]string{status.MetricLabelName: testObject.Name, status.MetricLabelConditionType: ConditionTypeFoo}, 1)
+		ExpectMetricNotFound("operator_status_condition_count", map[string]string{status.MetricLabelName: testObject.Name, status.MetricLabelConditionType: ConditionTypeBar})
+		ExpectMetricNotFound("operator_status_condition_count", map[string]string{status.MetricLabelName: testObject.Name, status.MetricLabelConditionType: status.ConditionReady})
+
+		testObject.StatusConditions().SetTrue(ConditionTypeFoo)
+		testObject.StatusConditions().SetTrue(ConditionTypeBar)
+		ExpectApplied(ctx, client, testObject)
+		ExpectReconciled(ctx, controller, testObject)
+		Expect(recorder.Events).To(Receive(Equal("Normal Foo Status condition transitioned, Type: Foo, Status: Unknown -> True, Reason: Foo map[operatorpkg.k8s.aws/from-status:Unknown operatorpkg.k8s.aws/reason:Foo operatorpkg.k8s.aws/to-status:True]")))
+		Expect(recorder.Events).To(BeEmpty())
+	})
+
+	It("should treat atomic conditions as owned by the manager of the conditions", func() {
+		controller = status.NewController[*TestObject](client, recorder, status.ControllerOpts{ConditionFieldManager: "foo-controller"})
+		testObject := test.Object(&TestObject{ObjectMeta: metav1.ObjectMeta{ManagedFields: []metav1.ManagedFieldsEntry{
+			{
+				Manager:     "foo-controller",
+				Operation:   metav1.ManagedFieldsOperationUpdate,
+				Subresource: "status",
+				FieldsType:  "FieldsV1",
+				FieldsV1:    &metav1.FieldsV1{Raw: []byte(`{"f:status":{"f:conditions":{}}}`)},
+			},
+		}}})
+		testObject.StatusConditions()
+		ExpectApplied(ctx, client, testObject)
+		ExpectReconciled(ctx, controller, testObject)
+		for _, conditionType := range []status.ConditionType{ConditionTypeFoo, ConditionTypeBar, status.ConditionReady} {
+			ExpectMetricValue("operator_status_condition_count", lo.Assign(map[string]string{status.MetricLabelName: testObject.Name}, conditionLabels(conditionType, metav1.ConditionUnknown)), 1)
+		}
+
+		// Conditions of an atomic list owned by another manager aren't owned
+		controller = status.NewController[*TestObject](client, recorder, status.ControllerOpts{ConditionFieldManager: "bar-controller"})
+		anotherObject := test.Object(&TestObject{ObjectMeta: metav1.ObjectMeta{ManagedFields: testObject.ManagedFields}})
+		anotherObject.StatusConditions()
+		ExpectApplied(ctx, client, anotherObject)
+		ExpectReconciled(ctx, controller, anotherObject)
+		ExpectMetricNotFound("operator_status_condition_count", map[string]string{status.MetricLabelName: anotherObject.Name})
+	})
+
+	It("should count reconciles since the object became ready", func() {
+		testObject := test.Object(&TestObject{})
+		labels := map[string]string{status.MetricLabelName: testObject.Name}
+		testObject.StatusConditions()
+		ExpectApplied(ctx, client, testObject)
+		ExpectReconciled(ctx, controller, testObject)
+		ExpectMetricNotFound("operator_status_post_ready_reconciles_total", labels)
+
+		testObject.StatusConditions().SetTrue(ConditionTypeFoo)
+		testObject.StatusConditions().SetTrue(ConditionTypeBar)
+		ExpectApplied(ctx, client, testObject)
+		for i := 1; i <= 3; i++ {
+			ExpectReconciled(ctx, controller, testObject)
+			ExpectMetricValue("operator_status_post_ready_reconciles_total", labels, float64(i))
+		}
+
+		// Reset once no longer ready
+		testObject.StatusConditions().SetFalse(ConditionTypeFoo, "reason", "message")
+		ExpectApplied(ctx, client, testObject)
+		ExpectReconciled(ctx, controller, testObject)
+		ExpectMetricNotFound("operator_status_post_ready_reconciles_total", labels)
+
+		testObject.StatusConditions().SetTrue(ConditionTypeFoo)
+		ExpectApplied(ctx, client, testObject)
+		ExpectReconciled(ctx, controller, testObject)
+		ExpectMetricValue("operator_status_post_ready_reconciles_total", labels, 1)
+
+		ExpectDeleted(ctx, client, testObject)
+		ExpectReconciled(ctx, controller, testObject)
+		ExpectMetricNotFound("operator_status_post_ready_reconciles_total", labels)
+	})
+
+	It("should throttle informational transitions but not critical transitions", func() {
+		fakeClock := clocktesting.NewFakeClock(time.Now())
+		controller = status.NewController[*TestObject](client, recorder, status.ControllerOpts{
+			Clock:         fakeClock,
+			EventThrottle: time.Minute,
+			TransitionSeverity: func(_, current status.Condition) status.Severity {
+				return lo.Ternary(current.IsFalse(), status.SeverityError, status.SeverityInfo)
+			},
+		})
+		testObject := test.Object(&TestObject{})
+		testObject.StatusConditions()
+		ExpectApplied(ctx, client, testObject)
+		ExpectReconciled(ctx, controller, testObject)
+
+		testObject.StatusConditions().SetTrue(ConditionTypeBar)
+		ExpectApplied(ctx, client, testObject)
+		ExpectReconciled(ctx, controller, testObject)
+		Expect(recorder.Events).To(Receive(Equal("Normal Bar Status condition transitioned, Type: Bar, Status: Unknown -> True, Reason: Bar map[operatorpkg.k8s.aws/from-status:Unknown operatorpkg.k8s.aws/reason:Bar operatorpkg.k8s.aws/to-status:True]")))
+
+		// Informational transitions within the throttle are dropped
+		testObject.StatusConditions().SetTrue(ConditionTypeFoo)
+		ExpectApplied(ctx, client, testObject)
+		ExpectReconciled(ctx, controller, testObject)
+		Expect(recorder.Events).To(BeEmpty())
+
+		// Error transitions are always emitted
+		testObject.StatusConditions().SetFalse(ConditionTypeFoo, "reason", "message")
+		ExpectApplied(ctx, client, testObject)
+		ExpectReconciled(ctx, controller, testObject)
+		Expect(recorder.Events).To(Receive(Equal("Warning Foo Status condition transitioned, Type: Foo, Status: True -> False, Reason: reason, Message: message map[operatorpkg.k8s.aws/from-status:True operatorpkg.k8s.aws/reason:reason operatorpkg.k8s.aws/to-status:False]")))
+		Expect(recorder.Events).To(Receive(Equal("Warning Ready Status condition transitioned, Type: Ready, Status: True -> False, Reason: UnhealthyDependents, Message: Foo=False map[operatorpkg.k8s.aws/from-status:True operatorpkg.k8s.aws/reason:UnhealthyDependents operatorpkg.k8s.aws/to-status:False]")))
+
+		// Informational transitions are emitted once the throttle has passed
+		fakeClock.Step(time.Minute)
+		testObject.StatusConditions().SetTrue(ConditionTypeFoo)
+		ExpectApplied(ctx, client, testObject)
+		ExpectReconciled(ctx, controller, testObject)
+		Expect(recorder.Events).To(Receive(Equal("Normal Foo Status condition transitioned, Type: Foo, Status: False -> True, Reason: Foo map[operatorpkg.k8s.aws/from-status:False operatorpkg.k8s.aws/reason:Foo operatorpkg.k8s.aws/to-status:True]")))
+		Expect(recorder.Events).To(BeEmpty())
+	})
+
+	It("should skip conditions read from a different object than the one reconciled", func() {
+		testObject := test.Object(&TestObject{})
+		ExpectApplied(ctx, client, testObject)
+		ExpectReconciled(ctx, controller, testObject)
+		mismatches := MetricValue("operator_status_adapter_mismatch_total", nil)
+
+		// The adapter holds the object as it was before the transition
+		stale := testObject.DeepCopy()
+		status.SetConditionsObject(controller, func(context.Context, *TestObject) (status.Object, error) { return stale, nil })
+		testObject.StatusConditions().SetTrue(ConditionTypeFoo)
+		ExpectApplied(ctx, client, testObject)
+		ExpectReconciled(ctx, controller, testObject)
+		ExpectMetricValue("operator_status_adapter_mismatch_total", nil, mismatches+1)
+		ExpectNoEvents(recorder)
+		ExpectMetricValue("operator_status_condition_count", lo.Assign(map[string]string{status.MetricLabelName: testObject.Name}, conditionLabels(ConditionTypeFoo, metav1.ConditionUnknown)), 1)
+		ExpectMetricNotFound("operator_status_condition_count", lo.Assign(map[string]string{status.MetricLabelName: testObject.Name}, conditionLabels(ConditionTypeFoo, metav1.ConditionTrue)))
+	})
+
+	It("should reconcile conditions held by a referenced object", func() {
+		referencedController := status.NewReferencedController(client, recorder, status.ConditionReference[*TestObject, *corev1.ConfigMap]{
+			Resolve: func(o *TestObject) types.NamespacedName {
+				return types.NamespacedName{Namespace: o.Namespace, Name: o.Name + "-status"}
+			},
+			GetConditions: func(cm *corev1.ConfigMap) ([]status.Condition, error) {
+				var conditions []status.Condition
+				return conditions, json.Unmarshal([]byte(cm.Data["conditions"]), &conditions)
+			},
+		})
+		testObject := test.Object(&TestObject{})
+		ExpectApplied(ctx, client, testObject)
+		ExpectReconciled(ctx, referencedController, testObject)
+		ExpectMetricNotFound("operator_status_condition_count", map[string]string{status.MetricLabelName: testObject.Name})
+
+		configMap := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Namespace: testObject.Namespace, Name: testObject.Name + "-status"},
+			Data:       map[string]string{"conditions": `[{"type":"Foo","status":"Unknown","reason":"reason","lastTransitionTime":"2024-01-01T00:00:00Z"}]`},
+		}
+		ExpectApplied(ctx, client, configMap)
+		ExpectReconciled(ctx, referencedController, testObject)
+		ExpectMetricValue("operator_status_condition_count", lo.Assign(map[string]string{status.MetricLabelName: testObject.Name}, conditionLabels(ConditionTypeFoo, metav1.ConditionUnknown)), 1)
+
+		configMap.Data["conditions"] = `[{"type":"Foo","status":"True","reason":"reason","lastTransitionTime":"2024-01-01T00:01:00Z"}]`
+		ExpectApplied(ctx, client, configMap)
+		ExpectReconciled(ctx, referencedController, testObject)
+		Expect(recorder.Events).To(Receive(Equal("Normal Foo Status condition transitioned, Type: Foo, Status: Unknown -> True, Reason: reason map[operatorpkg.k8s.aws/from-status:Unknown operatorpkg.k8s.aws/reason:reason operatorpkg.k8s.aws/to-status:True]")))
+		ExpectMetricNotFound("operator_status_condition_count", lo.Assign(map[string]string{status.MetricLabelName: testObject.Name}, conditionLabels(ConditionTypeFoo, metav1.ConditionUnknown)))
+		ExpectMetricValue("operator_status_condition_count", lo.Assign(map[string]string{status.MetricLabelName: testObject.Name}, conditionLabels(ConditionTypeFoo, metav1.ConditionTrue)), 1)
+	})
+	It("should recompute the root of conditions held by a referenced object", func() {
+		referencedController := status.NewReferencedController(client, recorder, status.ConditionReference[*TestObject, *corev1.ConfigMap]{
+			Resolve: func(o *TestObject) types.NamespacedName {
+				return types.NamespacedName{Namespace: o.Namespace, Name: o.Name + "-status"}
+			},
+			GetConditions: func(cm *corev1.ConfigMap) ([]status.Condition, error) {
+				var conditions []status.Condition
+				return conditions, json.Unmarshal([]byte(cm.Data["conditions"]), &conditions)
+			},
+			ConditionTypes: status.NewReadyConditions(ConditionTypeFoo),
+		})
+		testObject := test.Object(&TestObject{})
+		ExpectApplied(ctx, client, testObject)
+		ExpectApplied(ctx, client, &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Namespace: testObject.Namespace, Name: testObject.Name + "-status"},
+			Data:       map[string]string{"conditions": `[{"type":"Foo","status":"True","reason":"reason","lastTransitionTime":"2024-01-01T00:00:00Z"}]`},
+		})
+		ExpectReconciled(ctx, referencedController, testObject)
+		ExpectMetricValue("operator_status_condition_count", lo.Assign(map[string]string{status.MetricLabelName: testObject.Name}, conditionLabels(status.ConditionReady, metav1.ConditionTrue)), 1)
+		ExpectMetricValue("operator_status_post_ready_reconciles_total", map[string]string{status.MetricLabelName: testObject.Name}, 1)
+	})
+
+	It("should count conditions that are Unknown within the grace as pending", func() {
+		fakeClock := clocktesting.NewFakeClock(time.Now())
+		controller = status.NewController[*TestObject](client, recorder, status.ControllerOpts{Clock: fakeClock, UnknownGrace: time.Minute})
+		objectsByCondition := func(conditionStatus string) float64 {
+			return MetricValue("operator_status_objects_by_condition", map[string]string{
+				status.MetricLabelKind:            "TestObject",
+				status.MetricLabelConditionType:   ConditionTypeFoo,
+				status.MetricLabelConditionStatus: conditionStatus,
+			})
+		}
+		pending, unknown := objectsByCondition(status.MetricConditionStatusPending), objectsByCondition(string(metav1.ConditionUnknown))
+
+		testObject := test.Object(&TestObject{})
+		testObject.StatusConditions()
+		ExpectApplied(ctx, client, testObject)
+		ExpectReconciled(ctx, controller, testObject)
+		Expect(objectsByCondition(status.MetricConditionStatusPending)).To(Equal(pending + 1))
+		Expect(objectsByCondition(string(metav1.ConditionUnknown))).To(Equal(unknown))
+
+		fakeClock.Step(time.Minute)
+		ExpectReconciled(ctx, controller, testObject)
+		Expect(objectsByCondition(status.MetricConditionStatusPending)).To(Equal(pending))
+		Expect(objectsByCondition(string(metav1.ConditionUnknown))).To(Equal(unknown + 1))
+	})
+
+	It("should accumulate the total seconds spent in each status across transitions", func() {
+		start := time.Now().Truncate(time.Second)
+		fakeClock := clocktesting.NewFakeClock(start)
+		controller = status.NewController[*TestObject](client, recorder, status.ControllerOpts{Clock: fakeClock})
+		testObject := test.Object(&TestObject{Status: TestStatus{Conditions: []status.Condition{
+			{Type: ConditionTypeFoo, Status: metav1.ConditionUnknown, Reason: "reason", LastTransitionTime: metav1.NewTime(start)},
+		}}})
+		labels := func(conditionStatus metav1.ConditionStatus) map[string]string {
+			return lo.Assign(map[string]string{status.MetricLabelName: testObject.Name}, conditionLabels(ConditionTypeFoo, conditionStatus))
+		}
+		ExpectApplied(ctx, client, testObject)
+		ExpectReconciled(ctx, controller, testObject)
+		ExpectMetricValue("operator_status_condition_total_seconds", labels(metav1.ConditionUnknown), 0)
+
+		fakeClock.Step(30 * time.Second)
+		ExpectReconciled(ctx, controller, testObject)
+		ExpectMetricValue("operator_status_condition_total_seconds", labels(metav1.ConditionUnknown), 30)
+
+		// Time between the last reconcile and the transition is accumulated by the previous status
+		fakeClock.Step(30 * time.Second)
+		testObject.Status.Conditions = lo.Map(testObject.Status.Conditions, func(condition status.Condition, _ int) status.Condition {
+			if condition.Type == ConditionTypeFoo {
+				condition.Status, condition.LastTransitionTime = metav1.ConditionTrue, metav1.NewTime(start.Add(40*time.Second))
+			}
+			return condition
+		})
+		ExpectApplied(ctx, client, testObject)
+		ExpectReconciled(ctx, controller, testObject)
+		ExpectMetricValue("operator_status_condition_total_seconds", labels(metav1.ConditionUnknown), 40)
+		ExpectMetricValue("operator_status_condition_total_seconds", labels(metav1.ConditionTrue), 20)
+
+		fakeClock.Step(60 * time.Second)
+		testObject.Status.Conditions = lo.Map(testObject.Status.Conditions, func(condition status.Condition, _ int) status.Condition {
+			if condition.Type == ConditionTypeFoo {
+				condition.Status, condition.LastTransitionTime = metav1.ConditionUnknown, metav1.NewTime(start.Add(100*time.Second))
+			}
+			return condition
+		})
+		ExpectApplied(ctx, client, testObject)
+		ExpectReconciled(ctx, controller, testObject)
+		ExpectMetricValue("operator_status_condition_total_seconds", labels(metav1.ConditionUnknown), 60)
+		ExpectMetricValue("operator_status_condition_total_seconds", labels(metav1.ConditionTrue), 60)
+
+		// Moving the LastTransitionTime forward without a status change, e.g. by Touch, doesn't drop time
+		fakeClock.Step(60 * time.Second)
+		testObject.Status.Conditions = lo.Map(testObject.Status.Conditions, func(condition status.Condition, _ int) status.Condition {
+			if condition.Type == ConditionTypeFoo {
+				condition.LastTransitionTime = metav1.NewTime(start.Add(150 * time.Second))
+			}
+			return condition
+		})
+		ExpectApplied(ctx, client, testObject)
+		ExpectReconciled(ctx, controller, testObject)
+		ExpectMetricValue("operator_status_condition_total_seconds", labels(metav1.ConditionUnknown), 120)
+		ExpectMetricValue("operator_status_condition_total_seconds", labels(metav1.ConditionTrue), 60)
+	})
+
+	It("should compute current status seconds at scrape time", func() {
+		start := time.Now().Truncate(time.Second)
+		fakeClock := clocktesting.NewFakeClock(start)
+		controller = status.NewController[*TestObject](client, recorder, status.ControllerOpts{Clock: fakeClock, LazyCurrentStatusSeconds: true})
+		testObject := test.Object(&TestObject{Status: TestStatus{Conditions: []status.Condition{
+			{Type: ConditionTypeFoo, Status: metav1.ConditionFalse, Reason: "reason", LastTransitionTime: metav1.NewTime(start)},
+		}}})
+		ExpectApplied(ctx, client, testObject)
+		ExpectReconciled(ctx, controller, testObject)
+		ExpectMetricValue("operator_status_condition_current_status_seconds", lo.Assign(map[string]string{status.MetricLabelName: testObject.Name}, conditionLabels(ConditionTypeFoo, metav1.ConditionFalse)), 0)
+
+		// The value advances between reconciles
+		fakeClock.Step(time.Minute)
+		ExpectMetricValue("operator_status_condition_current_status_seconds", lo.Assign(map[string]string{status.MetricLabelName: testObject.Name}, conditionLabels(ConditionTypeFoo, metav1.ConditionFalse)), 60)
+		Expect(status.ConditionCurrentStatusSeconds.DeletePartialMatch(map[string]string{status.MetricLabelName: testObject.Name})).To(BeZero())
+
+		ExpectDeleted(ctx, client, testObject)
+		ExpectReconciled(ctx, controller, testObject)
+		ExpectMetricNotFound("operator_status_condition_current_status_seconds", map[string]string{status.MetricLabelName: testObject.Name})
+	})
+
+	It("should only emit metrics and events while the leader", func() {
+		leader := false
+		controller = status.NewController[*TestObject](client, recorder, status.ControllerOpts{IsLeader: func() bool { return leader }})
+		testObject := test.Object(&TestObject{})
+		testObject.StatusConditions()
+		ExpectApplied(ctx, client, testObject)
+		ExpectReconciled(ctx, controller, testObject)
+		ExpectMetricNotFound("operator_status_condition_count", map[string]string{status.MetricLabelName: testObject.Name})
+
+		leader = true
+		ExpectReconciled(ctx, controller, testObject)
+		ExpectMetricValue("operator_status_condition_count", lo.Assign(map[string]string{status.MetricLabelName: testObject.Name}, conditionLabels(ConditionTypeFoo, metav1.ConditionUnknown)), 1)
+
+		// Series are cleaned up when leadership is lost, and transitions while not the leader aren't recorded
+		leader = false
+		testObject.StatusConditions().SetTrue(ConditionTypeFoo)
+		ExpectApplied(ctx, client, testObject)
+		ExpectReconciled(ctx, controller, testObject)
+		ExpectMetricNotFound("operator_status_condition_count", map[string]string{status.MetricLabelName: testObject.Name})
+		Expect(recorder.Events).To(BeEmpty())
+	})
+
+	It("should measure the reconciles in progress", func() {
+		blocked := make(chan struct{})
+		blockingClient := interceptor.NewClient(fake.NewClientBuilder().WithScheme(scheme.Scheme).Build(), interceptor.Funcs{
+			Get: func(ctx context.Context, c ctrlclient.WithWatch, key ctrlclient.ObjectKey, obj ctrlclient.Object, opts ...ctrlclient.GetOption) error {
+				<-blocked
+				return c.Get(ctx, key, obj, opts...)
+			},
+		})
+		controller = status.NewController[*TestObject](blockingClient, recorder)
+		activeReconciles := func() float64 {
+			return MetricValue("operator_status_active_reconciles", map[string]string{status.MetricLabelKind: "TestObject"})
+		}
+		active := activeReconciles()
+
+		done := make(chan struct{})
+		for _, testObject := range []*TestObject{test.Object(&TestObject{}), test.Object(&TestObject{})} {
+			go func() {
+				defer GinkgoRecover()
+				ExpectReconciled(ctx, controller, testObject)
+				done <- struct{}{}
+			}()
+		}
+		// Both reconciles are in progress while blocked reading their objects
+		Eventually(activeReconciles).Should(Equal(active + 2))
+		close(blocked)
+		Eventually(done).Should(Receive())
+		Eventually(done).Should(Receive())
+		Expect(activeReconciles()).To(Equal(active))
+	})
+
+	It("should clamp negative transition durations", func() {
+		start := time.Now().Truncate(time.Second)
+		testObject := test.Object(&TestObject{Status: TestStatus{Conditions: []status.Condition{
+			{Type: ConditionTypeFoo, Status: metav1.ConditionUnknown, Reason: "reason", LastTransitionTime: metav1.NewTime(start.Add(time.Hour))},
+		}}})
+		negativeDurations := func() float64 {
+			return MetricValue("operator_status_negative_duration_total", map[string]string{status.MetricLabelKind: "TestObject"})
+		}
+		labels := lo.Assign(map[string]string{status.MetricLabelKind: "TestObject", status.MetricLabelVersion: "v1alpha1"}, conditionLabels(ConditionTypeFoo, metav1.ConditionUnknown))
+		count, sum, negative := MetricValue("operator_status_condition_transition_seconds", labels), MetricSum("operator_status_condition_transition_seconds", labels), negativeDurations()
+		ExpectApplied(ctx, client, testObject)
+		ExpectReconciled(ctx, controller, testObject)
+
+		// The condition transitions at a time before it was last observed to transition
+		testObject.Status.Conditions = lo.Map(testObject.Status.Conditions, func(condition status.Condition, _ int) status.Condition {
+			if condition.Type == ConditionTypeFoo {
+				condition.Status, condition.LastTransitionTime = metav1.ConditionTrue, metav1.NewTime(start)
+			}
+			return condition
+		})
+		ExpectApplied(ctx, client, testObject)
+		ExpectReconciled(ctx, controller, testObject)
+		Expect(negativeDurations()).To(Equal(negative + 1))
+		ExpectMetricValue("operator_status_condition_transition_seconds", labels, count+1)
+		Expect(MetricSum("operator_status_condition_transition_seconds", labels)).To(Equal(sum))
+	})
+
+	It("should record reason changes without a status change", func() {
+		reasonChanges := func() float64 {
+			return MetricValue("operator_status_condition_reason_changes_total", lo.Assign(map[string]string{status.MetricLabelKind: "TestObject", status.MetricLabelConditionReason: "Throttled"}, conditionLabels(ConditionTypeFoo, metav1.ConditionFalse)))
+		}
+		count := reasonChanges()
+		testObject := test.Object(&TestObject{})
+		testObject.StatusConditions().SetFalse(ConditionTypeFoo, "LaunchFailed", "message")
+		ExpectApplied(ctx, client, testObject)
+		ExpectReconciled(ctx, controller, testObject)
+
+		testObject.StatusConditions().SetFalse(ConditionTypeFoo, "Throttled", "message")
+		ExpectApplied(ctx, client, testObject)
+		ExpectReconciled(ctx, controller, testObject)
+		Expect(recorder.Events).To(Receive(Equal("Normal Foo Status condition reason changed, Type: Foo, Reason: LaunchFailed -> Throttled map[operatorpkg.k8s.aws/from-status:False operatorpkg.k8s.aws/reason:Throttled operatorpkg.k8s.aws/to-status:False]")))
+		Expect(recorder.Events).To(BeEmpty())
+		Expect(reasonChanges()).To(Equal(count + 1))
+
+		// Message changes alone aren't reason changes
+		testObject.StatusConditions().SetFalse(ConditionTypeFoo, "Throttled", "other message")
+		ExpectApplied(ctx, client, testObject)
+		ExpectReconciled(ctx, controller, testObject)
+		Expect(recorder.Events).To(BeEmpty())
+		Expect(reasonChanges()).To(Equal(count + 1))
+	})
+})
+
+type fakeEventSink struct {
+	events []status.TransitionEvent
+}
+
+func (s *fakeEventSink) Send(_ context.Context, event status.TransitionEvent) {
+	s.events = append(s.events, event)
 }
 
 func conditionLabels(t status.ConditionType, s metav1.ConditionStatus) map[string]string {
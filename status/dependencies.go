@@ -0,0 +1,117 @@
+package status
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/samber/lo"
+)
+
+// edges returns the dependency graph of the condition types, mapping each condition type to the condition
+// types it depends on. The root condition depends on its dependents.
+func (r ConditionTypes) edges() map[string][]string {
+	edges := map[string][]string{}
+	if r.root != "" {
+		edges[r.root] = r.dependents
+	}
+	for conditionType, dependencies := range r.dependencies {
+		edges[conditionType] = append(slices.Clone(edges[conditionType]), dependencies...)
+	}
+	return edges
+}
+
+// topologicalOrder returns the condition types reachable from the root, ordered so that each condition type follows
+// the condition types it depends on, and otherwise in the order they were declared. Cycles are broken arbitrarily,
+// see ValidateDependencyGraph.
+func (r ConditionTypes) topologicalOrder() []string {
+	edges := r.edges()
+	var order []string
+	visited := map[string]bool{}
+	var visit func(string)
+	visit = func(node string) {
+		if visited[node] {
+			return
+		}
+		visited[node] = true
+		lo.ForEach(edges[node], func(dependency string, _ int) { visit(dependency) })
+		order = append(order, node)
+	}
+	visit(r.root)
+	return order
+}
+
+// ValidateDependencyGraph checks that the condition types of the ConditionSet form a valid dependency graph,
+// i.e. that there are no cycles, that no condition type other than the root is depended on by nothing, and that
+// every condition type has a path to the root. It's intended for CI checks of a resource's condition model, e.g.
+//
+//	err := status.ValidateDependencyGraph((&v1.NodeClaim{}).StatusConditions())
+func ValidateDependencyGraph(cs ConditionSet) error {
+	if cs.root == "" {
+		return fmt.Errorf("condition set has no root condition")
+	}
+	edges := cs.edges()
+	nodes := lo.Uniq(append(lo.Keys(edges), lo.Flatten(lo.Values(edges))...))
+	sort.Strings(nodes)
+
+	var errs []error
+	if cycle := findCycle(nodes, edges); cycle != nil {
+		errs = append(errs, fmt.Errorf("dependency cycle %s", strings.Join(cycle, " -> ")))
+	}
+	dependedOn := lo.Flatten(lo.Values(edges))
+	if roots := lo.Filter(nodes, func(node string, _ int) bool { return !lo.Contains(dependedOn, node) }); len(roots) > 1 {
+		errs = append(errs, fmt.Errorf("duplicate roots %s, only %s may be depended on by no condition", strings.Join(roots, ", "), cs.root))
+	}
+	reachable := map[string]bool{}
+	var visit func(string)
+	visit = func(node string) {
+		if reachable[node] {
+			return
+		}
+		reachable[node] = true
+		lo.ForEach(edges[node], func(dependency string, _ int) { visit(dependency) })
+	}
+	visit(cs.root)
+	if orphans := lo.Reject(nodes, func(node string, _ int) bool { return reachable[node] }); len(orphans) > 0 {
+		errs = append(errs, fmt.Errorf("orphan conditions %s have no path to the root %s", strings.Join(orphans, ", "), cs.root))
+	}
+	return errors.Join(errs...)
+}
+
+// findCycle returns the first cycle in the graph, or nil if the graph is acyclic
+func findCycle(nodes []string, edges map[string][]string) []string {
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	state := map[string]int{}
+	var path []string
+	var visit func(string) []string
+	visit = func(node string) []string {
+		switch state[node] {
+		case visiting:
+			return append(path[lo.IndexOf(path, node):], node)
+		case visited:
+			return nil
+		}
+		state[node] = visiting
+		path = append(path, node)
+		for _, dependency := range edges[node] {
+			if cycle := visit(dependency); cycle != nil {
+				return cycle
+			}
+		}
+		path = path[:len(path)-1]
+		state[node] = visited
+		return nil
+	}
+	for _, node := range nodes {
+		if cycle := visit(node); cycle != nil {
+			return cycle
+		}
+	}
+	return nil
+}
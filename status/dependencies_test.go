@@ -0,0 +1,34 @@
+package status_test
+
+import (
+	"github.com/awslabs/operatorpkg/status"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ValidateDependencyGraph", func() {
+	It("should accept a valid graph", func() {
+		Expect(status.ValidateDependencyGraph((&TestObject{}).StatusConditions())).To(Succeed())
+		Expect(status.ValidateDependencyGraph(status.NewReadyConditions(ConditionTypeFoo, ConditionTypeBar).DependsOn(ConditionTypeBar, ConditionTypeFoo).For(&TestObject{}))).To(Succeed())
+	})
+	It("should detect a cycle", func() {
+		err := status.ValidateDependencyGraph(status.NewReadyConditions(ConditionTypeFoo, ConditionTypeBar).
+			DependsOn(ConditionTypeFoo, ConditionTypeBar).
+			DependsOn(ConditionTypeBar, ConditionTypeFoo).
+			For(&TestObject{}))
+		Expect(err).To(MatchError(ContainSubstring("dependency cycle Bar -> Foo -> Bar")))
+	})
+	It("should detect an orphan condition", func() {
+		err := status.ValidateDependencyGraph(status.NewReadyConditions(ConditionTypeFoo, ConditionTypeBar).DependsOn(ConditionTypeBaz, "Qux").For(&TestObject{}))
+		Expect(err).To(MatchError(ContainSubstring("orphan conditions Baz, Qux have no path to the root Ready")))
+		Expect(err).To(MatchError(ContainSubstring("duplicate roots Baz, Ready")))
+	})
+	It("should detect a condition set without a root", func() {
+		Expect(status.ValidateDependencyGraph(status.ConditionSet{})).To(MatchError(ContainSubstring("no root condition")))
+	})
+	It("should not mutate the condition types it was derived from", func() {
+		conditionTypes := status.NewReadyConditions(ConditionTypeFoo, ConditionTypeBar)
+		conditionTypes.DependsOn(ConditionTypeFoo, ConditionTypeBar).DependsOn(ConditionTypeBar, ConditionTypeFoo)
+		Expect(status.ValidateDependencyGraph(conditionTypes.For(&TestObject{}))).To(Succeed())
+	})
+})
@@ -0,0 +1,51 @@
+package status
+
+import (
+	"context"
+
+	v1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Annotations of the Kubernetes events recorded for transitions, so that event processors needn't parse the message
+const (
+	EventAnnotationFromStatus = "operatorpkg.k8s.aws/from-status"
+	EventAnnotationToStatus   = "operatorpkg.k8s.aws/to-status"
+	EventAnnotationReason     = "operatorpkg.k8s.aws/reason"
+)
+
+// TransitionEvent is a transition of a status condition observed by the status controller
+type TransitionEvent struct {
+	// Object is the object whose condition transitioned
+	Object client.Object
+	// Previous is the condition as it was last observed
+	Previous Condition
+	// Current is the condition after the transition
+	Current Condition
+	// Severity is the severity of the transition, see ControllerOpts.TransitionSeverity
+	Severity Severity
+}
+
+// Severity classifies transitions, so that critical transitions are always emitted, while informational
+// transitions may be throttled, see ControllerOpts.EventThrottle
+type Severity string
+
+const (
+	SeverityInfo    Severity = "Info"
+	SeverityWarning Severity = "Warning"
+	SeverityError   Severity = "Error"
+)
+
+// eventType returns the Kubernetes event type for transitions of the severity
+func (s Severity) eventType() string {
+	if s == SeverityWarning || s == SeverityError {
+		return v1.EventTypeWarning
+	}
+	return v1.EventTypeNormal
+}
+
+// EventSink receives the transitions observed by the status controller, e.g. to forward them to an audit pipeline.
+// Sinks are called synchronously from the reconcile loop, so implementations that block should buffer internally.
+type EventSink interface {
+	Send(ctx context.Context, event TransitionEvent)
+}
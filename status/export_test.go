@@ -0,0 +1,30 @@
+package status
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+)
+
+// ControllerOptions exposes the options used to register the controller to tests
+func ControllerOptions[T client.Object](c *Controller[T]) controller.Options {
+	return c.controllerOptions()
+}
+
+// EventFilter exposes the predicate used to filter the events of the controller to tests
+func EventFilter[T client.Object](c *Controller[T]) predicate.Predicate {
+	return c.eventFilter()
+}
+
+// Sweep runs the metric sweeper of the controller until the context is done
+func Sweep[T client.Object](ctx context.Context, c *Controller[T]) error {
+	return c.sweep(ctx)
+}
+
+// SetConditionsObject replaces how the controller reads the object holding the conditions of an object, e.g. to
+// simulate an adapter that reads conditions from a stale copy of the object
+func SetConditionsObject[T client.Object](c *Controller[T], resolve func(context.Context, T) (Object, error)) {
+	c.resolveConditions = resolve
+}
@@ -0,0 +1,30 @@
+package status
+
+import (
+	"github.com/spf13/pflag"
+)
+
+// BindFlags registers flags for the common ControllerOpts, returning opts that are populated when the flag set is parsed
+func BindFlags(fs *pflag.FlagSet) *ControllerOpts {
+	opts := &ControllerOpts{}
+	fs.BoolVar(&opts.ReadOnly, "status-read-only", false, "Never write to the API server from the status controller.")
+	fs.BoolVar(&opts.Checkpoint, "status-checkpoint", false, "Persist observed condition statuses to an annotation, so transitions are reported accurately across restarts.")
+	fs.BoolVar(&opts.TerminationOwnerLabel, "status-termination-owner-label", false, "Label termination metrics with the controlling owner of the object.")
+	fs.BoolVar(&opts.TerminationPropagationLabel, "status-termination-propagation-label", false, "Label termination metrics with how deletion of the object was requested.")
+	fs.StringVar(&opts.TerminationReasonAnnotation, "status-termination-reason-annotation", "", "Label termination metrics with the value of the annotation on the object when it's deleted.")
+	fs.StringVar(&opts.Namespace, "status-namespace", "", "Only observe objects in the namespace, which defaults to all namespaces.")
+	fs.StringVar(&opts.PauseAnnotation, "status-pause-annotation", "", "The annotation with which objects are paused, which defaults to operator.sh/paused.")
+	fs.BoolVar(&opts.OwnerReadyRatio, "status-owner-ready-ratio", false, "Emit the fraction of the objects of each controlling owner that are Ready.")
+	fs.DurationVar(&opts.TransitionHysteresis, "status-transition-hysteresis", 0, "The minimum duration a condition must hold a new status before the transition is recorded.")
+	fs.DurationVar(&opts.MinObjectAgeForMetrics, "status-min-object-age-for-metrics", 0, "The minimum age of an object before the current status seconds of its conditions are emitted.")
+	fs.DurationVar(&opts.MetricTTL, "status-metric-ttl", 0, "Garbage collect the metrics of objects that haven't been reconciled within the duration.")
+	fs.StringVar(&opts.ConditionFieldManager, "status-condition-field-manager", "", "Only observe conditions owned by the field manager.")
+	fs.DurationVar(&opts.UnknownGrace, "status-unknown-grace", 0, "The duration a condition may be Unknown before it's counted as Unknown rather than Pending.")
+	fs.BoolVar(&opts.LazyCurrentStatusSeconds, "status-lazy-current-status-seconds", false, "Compute the current status seconds of conditions at scrape time, rather than on every reconcile.")
+	fs.IntVar(&opts.MaxConcurrentReconciles, "status-max-concurrent-reconciles", 0, "The number of objects reconciled concurrently, which defaults to 10.")
+	fs.DurationVar(&opts.RequeueInterval, "status-requeue-interval", 0, "The interval at which objects are periodically reconciled, which defaults to 10s.")
+	fs.StringVar(&opts.MetricNamespace, "status-metric-namespace", "", "The prefix of the metric names, which defaults to operator.")
+	fs.BoolVar(&opts.ReducedCardinality, "status-reduced-cardinality", false, "Aggregate the condition count and current status seconds across objects, without per-object labels.")
+	fs.StringVar(&opts.MetricSubsystem, "status-metric-subsystem", "", "Separates the metric names of this controller from those of controllers for other kinds.")
+	return opts
+}
@@ -0,0 +1,28 @@
+package status_test
+
+import (
+	"time"
+
+	"github.com/awslabs/operatorpkg/status"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/pflag"
+)
+
+var _ = Describe("Flags", func() {
+	It("should populate opts from flags", func() {
+		fs := pflag.NewFlagSet("test", pflag.ContinueOnError)
+		opts := status.BindFlags(fs)
+		Expect(fs.Parse([]string{"--status-read-only", "--status-checkpoint=true", "--status-termination-owner-label=false", "--status-transition-hysteresis=30s"})).To(Succeed())
+		Expect(opts.ReadOnly).To(BeTrue())
+		Expect(opts.Checkpoint).To(BeTrue())
+		Expect(opts.TerminationOwnerLabel).To(BeFalse())
+		Expect(opts.TransitionHysteresis).To(Equal(time.Second * 30))
+	})
+	It("should default opts when flags aren't set", func() {
+		fs := pflag.NewFlagSet("test", pflag.ContinueOnError)
+		opts := status.BindFlags(fs)
+		Expect(fs.Parse(nil)).To(Succeed())
+		Expect(*opts).To(Equal(status.ControllerOpts{}))
+	})
+})
@@ -0,0 +1,55 @@
+package status
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/samber/lo"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	colorReset  = "\033[0m"
+	colorRed    = "\033[31m"
+	colorGreen  = "\033[32m"
+	colorYellow = "\033[33m"
+)
+
+// FormatConditions renders conditions in a human readable form, one condition per line, for use in CLI tools.
+// If color is true, statuses are colored with ANSI escape codes: True=green, False=red, Unknown=yellow.
+// Use ColorEnabled to decide whether the destination supports color.
+func FormatConditions(conditions []Condition, color bool) string {
+	return strings.Join(lo.Map(conditions, func(condition Condition, _ int) string {
+		return fmt.Sprintf("Type: %s, Status: %s, Reason: %s%s",
+			condition.Type,
+			lo.Ternary(color, colorize(condition.Status), string(condition.Status)),
+			condition.Reason,
+			lo.Ternary(condition.Message != "", fmt.Sprintf(", Message: %s", condition.Message), ""),
+		)
+	}), "\n")
+}
+
+// ColorEnabled returns true if the file is a terminal and the user hasn't opted out of color via NO_COLOR.
+// Output that is piped or redirected degrades to plain text.
+func ColorEnabled(f *os.File) bool {
+	if _, ok := os.LookupEnv("NO_COLOR"); ok {
+		return false
+	}
+	info, err := f.Stat()
+	if err != nil {
+		return false
+	}
+	return info.Mode()&os.ModeCharDevice != 0
+}
+
+func colorize(s metav1.ConditionStatus) string {
+	switch s {
+	case metav1.ConditionTrue:
+		return colorGreen + string(s) + colorReset
+	case metav1.ConditionFalse:
+		return colorRed + string(s) + colorReset
+	default:
+		return colorYellow + string(s) + colorReset
+	}
+}
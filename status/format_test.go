@@ -0,0 +1,38 @@
+package status_test
+
+import (
+	"os"
+
+	"github.com/awslabs/operatorpkg/status"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Format", func() {
+	It("should color statuses when enabled", func() {
+		testObject := TestObject{}
+		testObject.StatusConditions().SetTrue(ConditionTypeFoo)
+		testObject.StatusConditions().SetFalse(ConditionTypeBar, "reason", "message")
+
+		formatted := status.FormatConditions(testObject.GetConditions(), true)
+		Expect(formatted).To(ContainSubstring("Type: Foo, Status: \033[32mTrue\033[0m, Reason: Foo"))
+		Expect(formatted).To(ContainSubstring("Type: Bar, Status: \033[31mFalse\033[0m, Reason: reason, Message: message"))
+		Expect(formatted).To(ContainSubstring("Type: Ready, Status: \033[31mFalse\033[0m"))
+	})
+	It("should not color statuses when disabled", func() {
+		testObject := TestObject{}
+		testObject.StatusConditions().SetTrue(ConditionTypeFoo)
+
+		formatted := status.FormatConditions(testObject.GetConditions(), false)
+		Expect(formatted).ToNot(ContainSubstring("\033["))
+		Expect(formatted).To(Equal("Type: Bar, Status: Unknown, Reason: AwaitingReconciliation, Message: object is awaiting reconciliation\n" +
+			"Type: Foo, Status: True, Reason: Foo\n" +
+			"Type: Ready, Status: Unknown, Reason: UnhealthyDependents, Message: Bar=Unknown"))
+	})
+	It("should disable color when not writing to a terminal", func() {
+		f, err := os.CreateTemp(GinkgoT().TempDir(), "output")
+		Expect(err).ToNot(HaveOccurred())
+		defer f.Close()
+		Expect(status.ColorEnabled(f)).To(BeFalse())
+	})
+})
@@ -0,0 +1,73 @@
+package status
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/samber/lo"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// MarshalOptions configures the stable JSON form of a ConditionSet
+type MarshalOptions struct {
+	// OmitVolatileFields omits fields which change on every run, i.e. LastTransitionTime, e.g. for golden tests
+	OmitVolatileFields bool
+}
+
+// stableCondition mirrors Condition, but allows LastTransitionTime to be omitted
+type stableCondition struct {
+	Type               string                 `json:"type"`
+	Status             metav1.ConditionStatus `json:"status"`
+	ObservedGeneration int64                  `json:"observedGeneration,omitempty"`
+	LastTransitionTime *metav1.Time           `json:"lastTransitionTime,omitempty"`
+	Reason             string                 `json:"reason"`
+	Message            string                 `json:"message"`
+}
+
+// MarshalJSON marshals the conditions sorted by type, so that the output is stable regardless of the order in
+// which the conditions were set.
+func (c ConditionSet) MarshalJSON() ([]byte, error) {
+	return c.MarshalJSONWithOptions(MarshalOptions{})
+}
+
+// MarshalJSONWithOptions marshals the conditions sorted by type, see MarshalOptions.
+func (c ConditionSet) MarshalJSONWithOptions(opts MarshalOptions) ([]byte, error) {
+	conditions := lo.Map(c.List(), func(condition Condition, _ int) stableCondition {
+		return stableCondition{
+			Type:               condition.Type,
+			Status:             condition.Status,
+			ObservedGeneration: condition.ObservedGeneration,
+			LastTransitionTime: lo.Ternary(opts.OmitVolatileFields, nil, &condition.LastTransitionTime),
+			Reason:             condition.Reason,
+			Message:            condition.Message,
+		}
+	})
+	sort.SliceStable(conditions, func(i, j int) bool { return conditions[i].Type < conditions[j].Type })
+	return json.Marshal(conditions)
+}
+
+// UnmarshalJSON replaces the conditions of the object with those marshaled by MarshalJSON, e.g. to restore the
+// conditions of a golden fixture. Conditions without a LastTransitionTime are restored with the zero time.
+func (c *ConditionSet) UnmarshalJSON(data []byte) error {
+	if c.object == nil {
+		return fmt.Errorf("unmarshaling conditions, condition set has no object")
+	}
+	var stableConditions []stableCondition
+	if err := json.Unmarshal(data, &stableConditions); err != nil {
+		return fmt.Errorf("unmarshaling conditions, %w", err)
+	}
+	conditions := lo.Map(stableConditions, func(condition stableCondition, _ int) Condition {
+		return Condition{
+			Type:               condition.Type,
+			Status:             condition.Status,
+			ObservedGeneration: condition.ObservedGeneration,
+			LastTransitionTime: lo.FromPtr(condition.LastTransitionTime),
+			Reason:             condition.Reason,
+			Message:            condition.Message,
+		}
+	})
+	sort.SliceStable(conditions, func(i, j int) bool { return conditions[i].Type < conditions[j].Type })
+	c.object.SetConditions(conditions)
+	return nil
+}
@@ -0,0 +1,42 @@
+package status
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/samber/lo"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// managedConditionTypes returns the condition types whose entries in status.conditions are owned by the field manager,
+// according to the managedFields of the object. Conditions that are an atomic list, i.e. without per-item entries,
+// are owned as a whole by the manager of status.conditions, so all conditions are owned if that's the field manager.
+func managedConditionTypes(o client.Object, manager string) (conditionTypes []string, all bool) {
+	for _, entry := range o.GetManagedFields() {
+		if entry.Manager != manager || entry.FieldsV1 == nil {
+			continue
+		}
+		fields := map[string]map[string]map[string]json.RawMessage{}
+		if err := json.Unmarshal(entry.FieldsV1.Raw, &fields); err != nil {
+			continue
+		}
+		conditions, ok := fields["f:status"]["f:conditions"]
+		if !ok {
+			continue
+		}
+		// List items are keyed by their merge key, e.g. k:{"type":"Ready"}
+		items := lo.Filter(lo.Keys(conditions), func(key string, _ int) bool { return strings.HasPrefix(key, "k:") })
+		if len(items) == 0 {
+			return nil, true
+		}
+		for _, key := range items {
+			item := struct {
+				Type string `json:"type"`
+			}{}
+			if json.Unmarshal([]byte(strings.TrimPrefix(key, "k:")), &item) == nil {
+				conditionTypes = append(conditionTypes, item.Type)
+			}
+		}
+	}
+	return lo.Uniq(conditionTypes), false
+}
@@ -0,0 +1,33 @@
+package status
+
+import (
+	"sort"
+
+	"github.com/samber/lo"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// MetaConditions returns the conditions as upstream metav1.Conditions, for use with the condition helpers of
+// k8s.io/apimachinery/pkg/api/meta, e.g. meta.FindStatusCondition
+func (c ConditionSet) MetaConditions() []metav1.Condition {
+	return lo.Map(c.List(), func(condition Condition, _ int) metav1.Condition { return metav1.Condition(condition) })
+}
+
+// SetStatusCondition sets the condition with the semantics of meta.SetStatusCondition, where LastTransitionTime
+// only changes with the status, or is taken from the condition if set. In contrast, Set updates the
+// LastTransitionTime on any change. The root condition is recomputed after setting the condition.
+func (c ConditionSet) SetStatusCondition(condition metav1.Condition) (modified bool) {
+	if c.object == nil {
+		return false
+	}
+	conditions := c.MetaConditions()
+	if !meta.SetStatusCondition(&conditions, condition) {
+		return false
+	}
+	// Sorted for convenience of the consumer, i.e. kubectl.
+	sort.Slice(conditions, func(i, j int) bool { return conditions[i].Type < conditions[j].Type })
+	c.object.SetConditions(lo.Map(conditions, func(condition metav1.Condition, _ int) Condition { return Condition(condition) }))
+	c.recomputeRootCondition(condition.Type)
+	return true
+}
@@ -0,0 +1,653 @@
+package status
+
+import (
+	"errors"
+	"strings"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/samber/lo"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	MetricLabelGroup             = "group"
+	MetricLabelKind              = "kind"
+	MetricLabelVersion           = "version"
+	MetricLabelNamespace         = "namespace"
+	MetricLabelName              = "name"
+	MetricLabelConditionType     = "type"
+	MetricLabelConditionStatus   = "status"
+	MetricLabelConditionReason   = "reason"
+	MetricLabelField             = "field"
+	MetricLabelOwner             = "owner"
+	MetricLabelSkipReason        = "reason"
+	MetricLabelExternal          = "external"
+	MetricLabelPropagation       = "propagation"
+	MetricLabelTerminationReason = "reason"
+	MetricLabelFromStatus        = "from"
+	MetricLabelToStatus          = "to"
+)
+
+// MetricConditionStatusPending is the status with which ObjectsByCondition counts conditions that are Unknown within
+// the UnknownGrace
+const MetricConditionStatusPending = "Pending"
+
+const (
+	MetricNamespace = "operator"
+	MetricSubsystem = "status_condition"
+	// StatusMetricSubsystem is used for metrics that describe the status controller, rather than a condition
+	StatusMetricSubsystem      = "status"
+	TerminationMetricSubsystem = "termination"
+)
+
+// The help of metrics that are also emitted without per-object labels, see ControllerOpts.ReducedCardinality
+const (
+	conditionCountHelp                = "The number of an condition for a given object, type and status. e.g. Alarm := Available=False > 0"
+	conditionCurrentStatusSecondsHelp = "The current amount of time in seconds that a status condition has been in a specific state. e.g. Alarm := Ready=False > 10 minutes"
+)
+
+// Cardinality is limited to # objects * # conditions * # objectives
+var ConditionDuration = conditionDurationMetric(MetricNamespace)
+
+func conditionDurationMetric(namespace string, labels ...string) *prometheus.HistogramVec {
+	return prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: MetricSubsystem,
+			Name:      "transition_seconds",
+			Help:      "The amount of time a condition was in a given state before transitioning. e.g. Alarm := P99(Updated=False) > 5 minutes",
+		},
+		append([]string{
+			MetricLabelGroup,
+			MetricLabelKind,
+			MetricLabelVersion,
+			MetricLabelConditionType,
+			MetricLabelConditionStatus,
+		}, labels...),
+	)
+}
+
+// Cardinality is limited to # kinds * # conditions * # statuses
+var ConditionAgeAtDeletion = conditionAgeAtDeletionMetric(MetricNamespace)
+
+func conditionAgeAtDeletionMetric(namespace string, labels ...string) *prometheus.HistogramVec {
+	return prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: MetricSubsystem,
+			Name:      "age_at_deletion_seconds",
+			Help:      "The amount of time a condition was in its last observed state when the object was deleted. e.g. P99(age_at_deletion_seconds{type=Ready, status=False})",
+		},
+		append([]string{
+			MetricLabelGroup,
+			MetricLabelKind,
+			MetricLabelVersion,
+			MetricLabelConditionType,
+			MetricLabelConditionStatus,
+		}, labels...),
+	)
+}
+
+// Cardinality is limited to # objects * # conditions
+var ConditionCount = conditionCountMetric(MetricNamespace)
+
+func conditionCountMetric(namespace string, labels ...string) *prometheus.GaugeVec {
+	return prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricSubsystem,
+			Name:      "count",
+			Help:      conditionCountHelp,
+		},
+		append([]string{
+			MetricLabelNamespace,
+			MetricLabelName,
+			MetricLabelGroup,
+			MetricLabelKind,
+			MetricLabelVersion,
+			MetricLabelConditionType,
+			MetricLabelConditionStatus,
+		}, labels...),
+	)
+}
+
+// Cardinality is limited to # objects * # conditions
+var ConditionCurrentStatusSeconds = conditionCurrentStatusSecondsMetric(MetricNamespace)
+
+func conditionCurrentStatusSecondsMetric(namespace string, labels ...string) *prometheus.GaugeVec {
+	return prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricSubsystem,
+			Name:      "current_status_seconds",
+			Help:      conditionCurrentStatusSecondsHelp,
+		},
+		append([]string{
+			MetricLabelNamespace,
+			MetricLabelName,
+			MetricLabelGroup,
+			MetricLabelKind,
+			MetricLabelVersion,
+			MetricLabelConditionType,
+			MetricLabelConditionStatus,
+		}, labels...),
+	)
+}
+
+// Cardinality is limited to # objects * # conditions * # statuses
+var ConditionTotalSeconds = conditionTotalSecondsMetric(MetricNamespace)
+
+func conditionTotalSecondsMetric(namespace string, labels ...string) *prometheus.CounterVec {
+	return prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricSubsystem,
+			Name:      "total_seconds",
+			Help:      "The cumulative amount of time in seconds that a status condition has spent in a specific state across transitions. e.g. rate(total_seconds{type=Ready, status=False}[1d])",
+		},
+		append([]string{
+			MetricLabelNamespace,
+			MetricLabelName,
+			MetricLabelGroup,
+			MetricLabelKind,
+			MetricLabelVersion,
+			MetricLabelConditionType,
+			MetricLabelConditionStatus,
+		}, labels...),
+	)
+}
+
+// Cardinality is limited to # objects * # conditions * # reasons
+var ConditionTransitionsTotal = conditionTransitionsTotalMetric(MetricNamespace)
+
+func conditionTransitionsTotalMetric(namespace string, labels ...string) *prometheus.CounterVec {
+	return prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricSubsystem,
+			Name:      "transitions_total",
+			Help:      "The count of transitions of a given object, type and status.",
+		},
+		append([]string{
+			MetricLabelGroup,
+			MetricLabelKind,
+			MetricLabelVersion,
+			MetricLabelConditionType,
+			MetricLabelConditionStatus,
+			MetricLabelConditionReason,
+		}, labels...),
+	)
+}
+
+// Cardinality is limited to # kinds * # conditions * # statuses * # statuses
+var ConditionDirectedTransitionsTotal = conditionDirectedTransitionsTotalMetric(MetricNamespace)
+
+func conditionDirectedTransitionsTotalMetric(namespace string, labels ...string) *prometheus.CounterVec {
+	return prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricSubsystem,
+			Name:      "directed_transitions_total",
+			Help:      "The count of transitions of a condition from a status to another, which distinguishes recoveries from regressions. e.g. rate(directed_transitions_total{type=Ready, from=True, to=False}[1h])",
+		},
+		append([]string{
+			MetricLabelGroup,
+			MetricLabelKind,
+			MetricLabelVersion,
+			MetricLabelConditionType,
+			MetricLabelFromStatus,
+			MetricLabelToStatus,
+		}, labels...),
+	)
+}
+
+// Cardinality is limited to # kinds * # conditions
+var ConditionMessageChanges = conditionMessageChangesMetric(MetricNamespace)
+
+func conditionMessageChangesMetric(namespace string, labels ...string) *prometheus.CounterVec {
+	return prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricSubsystem,
+			Name:      "message_changes_total",
+			Help:      "The count of message changes of a condition without a status change, which may indicate a controller stuck retrying.",
+		},
+		append([]string{
+			MetricLabelGroup,
+			MetricLabelKind,
+			MetricLabelConditionType,
+		}, labels...),
+	)
+}
+
+// Cardinality is limited to # kinds * # conditions * # reasons
+var ConditionReasonChangesTotal = conditionReasonChangesTotalMetric(MetricNamespace)
+
+func conditionReasonChangesTotalMetric(namespace string, labels ...string) *prometheus.CounterVec {
+	return prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricSubsystem,
+			Name:      "reason_changes_total",
+			Help:      "The count of reason changes of a condition without a status change, e.g. a condition that stays False for a new reason.",
+		},
+		append([]string{
+			MetricLabelGroup,
+			MetricLabelKind,
+			MetricLabelConditionType,
+			MetricLabelConditionStatus,
+			MetricLabelConditionReason,
+		}, labels...),
+	)
+}
+
+// Cardinality is limited to # kinds
+var ReconcileGap = reconcileGapMetric(MetricNamespace)
+
+func reconcileGapMetric(namespace string) *prometheus.HistogramVec {
+	return prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: StatusMetricSubsystem,
+			Name:      "reconcile_gap_seconds",
+			Help:      "The amount of time between consecutive reconciles of the same object. e.g. Alarm := P99(reconcile_gap_seconds) > 5 minutes",
+			Buckets:   prometheus.ExponentialBuckets(1, 2, 12),
+		},
+		[]string{
+			MetricLabelGroup,
+			MetricLabelKind,
+		},
+	)
+}
+
+// Cardinality is limited to # objects * # fields
+var SpecStatusDiff = specStatusDiffMetric(MetricNamespace)
+
+func specStatusDiffMetric(namespace string) *prometheus.GaugeVec {
+	return prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: StatusMetricSubsystem,
+			Name:      "spec_status_diff",
+			Help:      "The difference between a numeric field in the spec and the corresponding field in the status. e.g. Alarm := spec_status_diff{field=replicas} > 0 for 10 minutes",
+		},
+		[]string{
+			MetricLabelNamespace,
+			MetricLabelName,
+			MetricLabelGroup,
+			MetricLabelKind,
+			MetricLabelField,
+		},
+	)
+}
+
+// Cardinality is limited to # kinds * # owners * # propagation policies
+var TerminationDuration = terminationDurationMetric(MetricNamespace)
+
+func terminationDurationMetric(namespace string, labels ...string) *prometheus.HistogramVec {
+	return prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: TerminationMetricSubsystem,
+			Name:      "duration_seconds",
+			Help:      "The amount of time taken by an object to terminate completely. e.g. Alarm := P99(duration_seconds) > 10 minutes",
+		},
+		append([]string{
+			MetricLabelGroup,
+			MetricLabelKind,
+		}, labels...),
+	)
+}
+
+// Cardinality is limited to # objects * # conditions
+var ConditionStale = conditionStaleMetric(MetricNamespace)
+
+func conditionStaleMetric(namespace string) *prometheus.GaugeVec {
+	return prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricSubsystem,
+			Name:      "stale",
+			Help:      "Whether a condition's observedGeneration is behind the object's generation. e.g. Alarm := stale{type=Ready} > 0 for 10 minutes",
+		},
+		[]string{
+			MetricLabelNamespace,
+			MetricLabelName,
+			MetricLabelGroup,
+			MetricLabelKind,
+			MetricLabelConditionType,
+		},
+	)
+}
+
+// Cardinality is limited to # objects * # conditions
+var ConditionObservedGenerationLag = conditionObservedGenerationLagMetric(MetricNamespace)
+
+func conditionObservedGenerationLagMetric(namespace string) *prometheus.GaugeVec {
+	return prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricSubsystem,
+			Name:      "observed_generation_lag",
+			Help:      "The number of generations by which a condition's observedGeneration is behind the object's generation. e.g. Alarm := observed_generation_lag{type=Ready} > 0 for 10 minutes",
+		},
+		[]string{
+			MetricLabelNamespace,
+			MetricLabelName,
+			MetricLabelGroup,
+			MetricLabelKind,
+			MetricLabelConditionType,
+		},
+	)
+}
+
+// Cardinality is limited to # kinds * # conditions * # statuses
+var ObjectsByCondition = objectsByConditionMetric(MetricNamespace)
+
+func objectsByConditionMetric(namespace string) *prometheus.GaugeVec {
+	return prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: StatusMetricSubsystem,
+			Name:      "objects_by_condition",
+			Help:      "The number of distinct objects with a given condition type and status. e.g. Alarm := objects_by_condition{type=Ready, status=False} > 5",
+		},
+		[]string{
+			MetricLabelGroup,
+			MetricLabelKind,
+			MetricLabelVersion,
+			MetricLabelConditionType,
+			MetricLabelConditionStatus,
+		},
+	)
+}
+
+// Cardinality is limited to # kinds * # skip reasons
+var ReconcilesSkipped = reconcilesSkippedMetric(MetricNamespace)
+
+func reconcilesSkippedMetric(namespace string) *prometheus.CounterVec {
+	return prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: StatusMetricSubsystem,
+			Name:      "reconciles_skipped_total",
+			Help:      "The count of reconciles skipped by a predicate, e.g. for paused objects.",
+		},
+		[]string{
+			MetricLabelGroup,
+			MetricLabelKind,
+			MetricLabelSkipReason,
+		},
+	)
+}
+
+// Cardinality is limited to # kinds
+var ActiveReconciles = activeReconcilesMetric(MetricNamespace)
+
+func activeReconcilesMetric(namespace string) *prometheus.GaugeVec {
+	return prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: StatusMetricSubsystem,
+			Name:      "active_reconciles",
+			Help:      "The number of reconciles in progress, which is bounded by MaxConcurrentReconciles. e.g. max_over_time(active_reconciles[1h])",
+		},
+		[]string{
+			MetricLabelGroup,
+			MetricLabelKind,
+		},
+	)
+}
+
+// Cardinality is limited to # kinds
+var NegativeDurations = negativeDurationsMetric(MetricNamespace)
+
+func negativeDurationsMetric(namespace string) *prometheus.CounterVec {
+	return prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: StatusMetricSubsystem,
+			Name:      "negative_duration_total",
+			Help:      "The count of transitions whose duration was negative, e.g. due to clock adjustments, and was clamped to zero.",
+		},
+		[]string{
+			MetricLabelGroup,
+			MetricLabelKind,
+		},
+	)
+}
+
+// Cardinality is limited to # kinds
+var ColdObservations = coldObservationsMetric(MetricNamespace)
+
+func coldObservationsMetric(namespace string) *prometheus.CounterVec {
+	return prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: StatusMetricSubsystem,
+			Name:      "cold_observations_total",
+			Help:      "The count of objects observed without a prior observation, e.g. after a controller restart or cache invalidation. e.g. Alarm := rate(cold_observations_total) > rate of object creation",
+		},
+		[]string{
+			MetricLabelGroup,
+			MetricLabelKind,
+		},
+	)
+}
+
+// Cardinality is limited to # kinds
+var AdapterMismatches = adapterMismatchesMetric(MetricNamespace)
+
+func adapterMismatchesMetric(namespace string) *prometheus.CounterVec {
+	return prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: StatusMetricSubsystem,
+			Name:      "adapter_mismatch_total",
+			Help:      "The count of reconciles skipped because the conditions were read from a different object than the one reconciled, e.g. a stale copy, which indicates a programming error.",
+		},
+		[]string{
+			MetricLabelGroup,
+			MetricLabelKind,
+		},
+	)
+}
+
+// Cardinality is limited to # objects
+var PostReadyReconciles = postReadyReconcilesMetric(MetricNamespace)
+
+func postReadyReconcilesMetric(namespace string) *prometheus.CounterVec {
+	return prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: StatusMetricSubsystem,
+			Name:      "post_ready_reconciles_total",
+			Help:      "The count of reconciles of an object since its root condition became True, which is reset when it's no longer True. e.g. Alarm := rate(post_ready_reconciles_total) > 1",
+		},
+		[]string{
+			MetricLabelNamespace,
+			MetricLabelName,
+			MetricLabelGroup,
+			MetricLabelKind,
+		},
+	)
+}
+
+// Cardinality is limited to # kinds
+var WebhookFailures = webhookFailuresMetric(MetricNamespace)
+
+func webhookFailuresMetric(namespace string) *prometheus.CounterVec {
+	return prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: StatusMetricSubsystem,
+			Name:      "webhook_failures_total",
+			Help:      "The count of transitions that a WebhookSink failed to deliver after all retries.",
+		},
+		[]string{
+			MetricLabelGroup,
+			MetricLabelKind,
+		},
+	)
+}
+
+// Cardinality is limited to # kinds
+var WebhookDropped = webhookDroppedMetric(MetricNamespace)
+
+func webhookDroppedMetric(namespace string) *prometheus.CounterVec {
+	return prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: StatusMetricSubsystem,
+			Name:      "webhook_dropped_total",
+			Help:      "The count of transitions that a WebhookSink dropped because its queue was full, e.g. while the webhook is slow or unavailable.",
+		},
+		[]string{
+			MetricLabelGroup,
+			MetricLabelKind,
+		},
+	)
+}
+
+func init() {
+	register(ConditionCount)
+	register(ConditionDuration)
+	register(ConditionAgeAtDeletion)
+	register(ConditionCurrentStatusSeconds)
+	register(ConditionTotalSeconds)
+	register(ConditionTransitionsTotal)
+	register(ConditionDirectedTransitionsTotal)
+	register(ConditionMessageChanges)
+	register(ConditionReasonChangesTotal)
+	register(ReconcileGap)
+	register(SpecStatusDiff)
+	register(TerminationDuration)
+	register(ConditionStale)
+	register(ConditionObservedGenerationLag)
+	register(ObjectsByCondition)
+	register(ReconcilesSkipped)
+	register(PostReadyReconciles)
+	register(ActiveReconciles)
+	register(NegativeDurations)
+	register(ColdObservations)
+	register(AdapterMismatches)
+	register(WebhookFailures)
+	register(WebhookDropped)
+}
+
+// controllerMetrics are the metrics emitted by a status controller. Controllers configured with the same
+// metric namespace and labels share metrics, and the default configuration uses the package level metrics.
+type controllerMetrics struct {
+	ConditionCount                    *prometheus.GaugeVec
+	ConditionDuration                 *prometheus.HistogramVec
+	ConditionAgeAtDeletion            *prometheus.HistogramVec
+	ConditionCurrentStatusSeconds     *prometheus.GaugeVec
+	ConditionTotalSeconds             *prometheus.CounterVec
+	ConditionTransitionsTotal         *prometheus.CounterVec
+	ConditionDirectedTransitionsTotal *prometheus.CounterVec
+	ConditionMessageChanges           *prometheus.CounterVec
+	ConditionReasonChangesTotal       *prometheus.CounterVec
+	ReconcileGap                      *prometheus.HistogramVec
+	SpecStatusDiff                    *prometheus.GaugeVec
+	TerminationDuration               *prometheus.HistogramVec
+	ConditionStale                    *prometheus.GaugeVec
+	ConditionObservedGenerationLag    *prometheus.GaugeVec
+	ObjectsByCondition                *prometheus.GaugeVec
+	ReconcilesSkipped                 *prometheus.CounterVec
+	PostReadyReconciles               *prometheus.CounterVec
+	ActiveReconciles                  *prometheus.GaugeVec
+	NegativeDurations                 *prometheus.CounterVec
+	ColdObservations                  *prometheus.CounterVec
+	AdapterMismatches                 *prometheus.CounterVec
+}
+
+// newControllerMetrics constructs metrics with the additional labels appended to the condition metrics
+func newControllerMetrics(namespace string, labels ...string) controllerMetrics {
+	return controllerMetrics{
+		ConditionCount:                    register(conditionCountMetric(namespace, labels...)),
+		ConditionDuration:                 register(conditionDurationMetric(namespace, labels...)),
+		ConditionAgeAtDeletion:            register(conditionAgeAtDeletionMetric(namespace, labels...)),
+		ConditionCurrentStatusSeconds:     register(conditionCurrentStatusSecondsMetric(namespace, labels...)),
+		ConditionTotalSeconds:             register(conditionTotalSecondsMetric(namespace, labels...)),
+		ConditionTransitionsTotal:         register(conditionTransitionsTotalMetric(namespace, labels...)),
+		ConditionDirectedTransitionsTotal: register(conditionDirectedTransitionsTotalMetric(namespace, labels...)),
+		ConditionMessageChanges:           register(conditionMessageChangesMetric(namespace, labels...)),
+		ConditionReasonChangesTotal:       register(conditionReasonChangesTotalMetric(namespace, labels...)),
+		ReconcileGap:                      register(reconcileGapMetric(namespace)),
+		SpecStatusDiff:                    register(specStatusDiffMetric(namespace)),
+		TerminationDuration:               register(terminationDurationMetric(namespace)),
+		ConditionStale:                    register(conditionStaleMetric(namespace)),
+		ConditionObservedGenerationLag:    register(conditionObservedGenerationLagMetric(namespace)),
+		ObjectsByCondition:                register(objectsByConditionMetric(namespace)),
+		ReconcilesSkipped:                 register(reconcilesSkippedMetric(namespace)),
+		PostReadyReconciles:               register(postReadyReconcilesMetric(namespace)),
+		ActiveReconciles:                  register(activeReconcilesMetric(namespace)),
+		NegativeDurations:                 register(negativeDurationsMetric(namespace)),
+		ColdObservations:                  register(coldObservationsMetric(namespace)),
+		AdapterMismatches:                 register(adapterMismatchesMetric(namespace)),
+	}
+}
+
+// objectMetrics returns the metrics with series for each object, which are cleaned up when the object is deleted
+func (m controllerMetrics) objectMetrics() []*prometheus.MetricVec {
+	return []*prometheus.MetricVec{
+		m.ConditionCount.MetricVec,
+		m.ConditionCurrentStatusSeconds.MetricVec,
+		m.ConditionTotalSeconds.MetricVec,
+		m.SpecStatusDiff.MetricVec,
+		m.ConditionStale.MetricVec,
+		m.ConditionObservedGenerationLag.MetricVec,
+		m.PostReadyReconciles.MetricVec,
+	}
+}
+
+// conditionMetrics returns the metrics with series for each condition type of an object, see objectMetrics
+func (m controllerMetrics) conditionMetrics() []*prometheus.MetricVec {
+	return []*prometheus.MetricVec{
+		m.ConditionCount.MetricVec,
+		m.ConditionCurrentStatusSeconds.MetricVec,
+		m.ConditionTotalSeconds.MetricVec,
+		m.ConditionStale.MetricVec,
+		m.ConditionObservedGenerationLag.MetricVec,
+	}
+}
+
+var (
+	collectors     = map[string]prometheus.Collector{}
+	collectorsLock sync.Mutex
+)
+
+// register registers the collector with the controller-runtime registry, returning the previously registered
+// collector if one with identical descriptors exists. A collector that shares a name with a registered collector,
+// but has different labels, is registered unchecked, since Prometheus permits differing labels within a family.
+func register[C prometheus.Collector](collector C) C {
+	collectorsLock.Lock()
+	defer collectorsLock.Unlock()
+
+	descs := make(chan *prometheus.Desc)
+	go func() {
+		collector.Describe(descs)
+		close(descs)
+	}()
+	key := strings.Join(lo.Map(lo.ChannelToSlice(descs), func(desc *prometheus.Desc, _ int) string { return desc.String() }), ",")
+	if existing, ok := collectors[key]; ok {
+		return existing.(C)
+	}
+	if err := metrics.Registry.Register(collector); err != nil {
+		alreadyRegisteredError := prometheus.AlreadyRegisteredError{}
+		if errors.As(err, &alreadyRegisteredError) {
+			return alreadyRegisteredError.ExistingCollector.(C)
+		}
+		lo.Must0(metrics.Registry.Register(uncheckedCollector{collector}))
+	}
+	collectors[key] = collector
+	return collector
+}
+
+// uncheckedCollector hides the descriptors of a collector, so that the registry doesn't check them for consistency
+type uncheckedCollector struct {
+	prometheus.Collector
+}
+
+func (uncheckedCollector) Describe(chan<- *prometheus.Desc) {}
@@ -0,0 +1,32 @@
+package status
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// pruneUnknownConditions removes conditions whose types aren't known from the conditions of the object held in
+// memory, so that the metrics of retired condition types are cleaned up, see ControllerOpts.KnownConditionTypes.
+// The root condition and its dependents are always known. Unless ReadOnly, unknown conditions are also pruned from
+// the object on the API server if ControllerOpts.PruneUnknownConditions. Returns the types that were removed.
+func (c *Controller[T]) pruneUnknownConditions(ctx context.Context, o T, so Object) ([]string, error) {
+	// Resolve the root and dependents from a copy, since StatusConditions initializes missing conditions
+	conditionTypes := so.DeepCopyObject().(Object).StatusConditions().ConditionTypes
+	known := append(slices.Clone(c.opts.KnownConditionTypes), append(slices.Clone(conditionTypes.dependents), conditionTypes.root)...)
+	stored := o.DeepCopyObject().(T)
+	removed := ConditionSet{object: so}.Prune(known...)
+	// Conditions resolved from another object can't be pruned from this object
+	if len(removed) == 0 || !c.opts.PruneUnknownConditions || c.opts.ReadOnly || c.resolveConditions != nil {
+		return removed, nil
+	}
+	// Patch a copy of the object as it was stored, since the in memory conditions of the object may be modified
+	pruned := c.statusObject(stored.DeepCopyObject().(T))
+	ConditionSet{object: pruned}.Prune(known...)
+	if err := c.kubeClient.Status().Patch(ctx, c.unwrap(pruned), client.MergeFrom(stored), client.FieldOwner(FieldManager)); client.IgnoreNotFound(err) != nil {
+		return nil, fmt.Errorf("pruning unknown conditions, %w", err)
+	}
+	return removed, nil
+}
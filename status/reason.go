@@ -0,0 +1,37 @@
+package status
+
+import (
+	"context"
+	"errors"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+// Canonical reasons for errors, see BoundedReason
+const (
+	ConditionReasonTimeout   ConditionReason = "Timeout"
+	ConditionReasonConflict  ConditionReason = "Conflict"
+	ConditionReasonNotFound  ConditionReason = "NotFound"
+	ConditionReasonForbidden ConditionReason = "Forbidden"
+	ConditionReasonOther     ConditionReason = "Other"
+)
+
+// BoundedReason maps an error to one of a small set of canonical reasons, so that errors can be reported by a
+// condition without exploding the cardinality of the reason label of metrics. The error itself belongs in the
+// message, e.g.
+//
+//	conditions.SetFalse(ConditionTypeRegistered, status.ConditionReason(status.BoundedReason(err)), err.Error())
+func BoundedReason(err error) string {
+	switch {
+	case apierrors.IsTimeout(err) || apierrors.IsServerTimeout(err) || errors.Is(err, context.DeadlineExceeded):
+		return string(ConditionReasonTimeout)
+	case apierrors.IsConflict(err):
+		return string(ConditionReasonConflict)
+	case apierrors.IsNotFound(err):
+		return string(ConditionReasonNotFound)
+	case apierrors.IsForbidden(err) || apierrors.IsUnauthorized(err):
+		return string(ConditionReasonForbidden)
+	default:
+		return string(ConditionReasonOther)
+	}
+}
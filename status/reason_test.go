@@ -0,0 +1,32 @@
+package status_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/awslabs/operatorpkg/status"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+var _ = Describe("BoundedReason", func() {
+	resource := schema.GroupResource{Group: "operators.k8s.aws", Resource: "testobjects"}
+	DescribeTable("should map errors to canonical reasons",
+		func(err error, reason status.ConditionReason) {
+			Expect(status.BoundedReason(err)).To(Equal(string(reason)))
+		},
+		Entry("timeout", apierrors.NewTimeoutError("timed out", 1), status.ConditionReasonTimeout),
+		Entry("server timeout", apierrors.NewServerTimeout(resource, "get", 1), status.ConditionReasonTimeout),
+		Entry("deadline exceeded", fmt.Errorf("getting object, %w", context.DeadlineExceeded), status.ConditionReasonTimeout),
+		Entry("conflict", apierrors.NewConflict(resource, "name", errors.New("conflict")), status.ConditionReasonConflict),
+		Entry("not found", apierrors.NewNotFound(resource, "name"), status.ConditionReasonNotFound),
+		Entry("wrapped not found", fmt.Errorf("getting object, %w", apierrors.NewNotFound(resource, "name")), status.ConditionReasonNotFound),
+		Entry("forbidden", apierrors.NewForbidden(resource, "name", errors.New("forbidden")), status.ConditionReasonForbidden),
+		Entry("unauthorized", apierrors.NewUnauthorized("unauthorized"), status.ConditionReasonForbidden),
+		Entry("other api error", apierrors.NewBadRequest("bad request"), status.ConditionReasonOther),
+		Entry("other error", errors.New("error"), status.ConditionReasonOther),
+	)
+})
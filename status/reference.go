@@ -0,0 +1,83 @@
+package status
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/awslabs/operatorpkg/object"
+)
+
+// ConditionReference resolves the object holding the conditions of a primary object, e.g. a ConfigMap to which
+// an operator externalizes the status of the primary object
+type ConditionReference[T client.Object, R client.Object] struct {
+	// Resolve returns the key of the referenced object holding the conditions of the primary object
+	Resolve func(T) types.NamespacedName
+	// GetConditions decodes the conditions held by the referenced object
+	GetConditions func(R) ([]Condition, error)
+	// ConditionTypes are the root condition and dependents of the referenced conditions, e.g.
+	// NewReadyConditions(ConditionTypeLaunched). Without them the conditions have no root condition, so the root isn't
+	// recomputed from its dependents, and metrics of the root condition, e.g. PostReadyReconciles, aren't emitted.
+	ConditionTypes ConditionTypes
+}
+
+// NewReferencedController constructs a controller that reads the conditions of a primary object from a referenced
+// object. Metrics and events are keyed by the primary object. A referenced object that doesn't exist holds no
+// conditions. Changes to the referenced object are observed by the periodic requeue of the primary object.
+func NewReferencedController[T client.Object, R client.Object](client client.Client, eventRecorder record.EventRecorder, reference ConditionReference[T, R], opts ...ControllerOpts) *Controller[T] {
+	c := NewControllerWithAccessors(client, eventRecorder, ConditionAccessors[T]{}, opts...)
+	c.resolveConditions = func(ctx context.Context, o T) (Object, error) {
+		referenced := object.New[R]()
+		if err := c.kubeClient.Get(ctx, reference.Resolve(o), referenced); err != nil {
+			if errors.IsNotFound(err) {
+				return &memoryObject{Object: o, conditionTypes: reference.ConditionTypes}, nil
+			}
+			return nil, fmt.Errorf("getting referenced object, %w", err)
+		}
+		conditions, err := reference.GetConditions(referenced)
+		if err != nil {
+			return nil, fmt.Errorf("decoding referenced conditions, %w", err)
+		}
+		return &memoryObject{Object: o, conditions: conditions, conditionTypes: reference.ConditionTypes}, nil
+	}
+	return c
+}
+
+// memoryObject implements Object by holding conditions in memory for an object, e.g. conditions read from a
+// referenced object, or restored from a checkpoint
+type memoryObject struct {
+	client.Object
+	conditions     []Condition
+	conditionTypes ConditionTypes
+}
+
+func (m *memoryObject) GetConditions() []Condition {
+	return m.conditions
+}
+
+func (m *memoryObject) SetConditions(conditions []Condition) {
+	m.conditions = conditions
+}
+
+// StatusConditions returns the conditions with the root condition recomputed from its dependents, if the condition
+// types are known
+func (m *memoryObject) StatusConditions() ConditionSet {
+	if m.conditionTypes.root == "" {
+		return ConditionSet{object: m}
+	}
+	conditions := m.conditionTypes.For(m)
+	if len(conditions.dependents) > 0 {
+		conditions.recomputeRootCondition(conditions.dependents[0])
+	}
+	return conditions
+}
+
+func (m *memoryObject) DeepCopyObject() runtime.Object {
+	return &memoryObject{Object: m.Object.DeepCopyObject().(client.Object), conditions: slices.Clone(m.conditions), conditionTypes: m.conditionTypes}
+}
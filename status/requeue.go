@@ -0,0 +1,19 @@
+package status
+
+import (
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// RequeueFor returns a result that requeues after readyInterval if the root condition is True, and otherwise after
+// notReadyInterval, so that controllers converge quickly on objects that aren't ready while polling stable objects
+// less frequently, e.g.
+//
+//	return status.RequeueFor(o.StatusConditions(), time.Minute, 5*time.Second), nil
+func RequeueFor(cs ConditionSet, readyInterval, notReadyInterval time.Duration) reconcile.Result {
+	if root := cs.Root(); root != nil && root.IsTrue() {
+		return reconcile.Result{RequeueAfter: readyInterval}
+	}
+	return reconcile.Result{RequeueAfter: notReadyInterval}
+}
@@ -0,0 +1,25 @@
+package status_test
+
+import (
+	"time"
+
+	"github.com/awslabs/operatorpkg/status"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+var _ = Describe("RequeueFor", func() {
+	It("should requeue after the ready interval when the root condition is True", func() {
+		testObject := TestObject{}
+		testObject.StatusConditions().SetTrue(ConditionTypeFoo)
+		testObject.StatusConditions().SetTrue(ConditionTypeBar)
+		Expect(status.RequeueFor(testObject.StatusConditions(), time.Minute, 5*time.Second)).To(Equal(reconcile.Result{RequeueAfter: time.Minute}))
+	})
+	It("should requeue after the not ready interval when the root condition isn't True", func() {
+		testObject := TestObject{}
+		Expect(status.RequeueFor(testObject.StatusConditions(), time.Minute, 5*time.Second)).To(Equal(reconcile.Result{RequeueAfter: 5 * time.Second}))
+		testObject.StatusConditions().SetFalse(ConditionTypeFoo, "reason", "message")
+		Expect(status.RequeueFor(testObject.StatusConditions(), time.Minute, 5*time.Second)).To(Equal(reconcile.Result{RequeueAfter: 5 * time.Second}))
+	})
+})
@@ -1,6 +1,7 @@
 package status_test
 
 import (
+	"slices"
 	"testing"
 
 	"github.com/awslabs/operatorpkg/status"
@@ -22,7 +23,9 @@ func Test(t *testing.T) {
 
 var (
 	SchemeBuilder = runtime.NewSchemeBuilder(func(scheme *runtime.Scheme) error {
-		scheme.AddKnownTypes(schema.GroupVersion{Group: test.APIGroup, Version: "v1alpha1"}, &TestObject{})
+		scheme.AddKnownTypes(schema.GroupVersion{Group: test.APIGroup, Version: "v1alpha1"}, &TestObject{}, &TestAccessorObject{}, &TestCodecObject{})
+		scheme.AddKnownTypeWithName(schema.GroupVersionKind{Group: test.APIGroup, Version: "v1", Kind: "TestObject"}, &TestObjectV1{})
+		scheme.AddKnownTypes(schema.GroupVersion{Group: AggregatedAPIGroup, Version: "v1beta1"}, &TestAggregatedObject{})
 		return nil
 	})
 )
@@ -32,11 +35,18 @@ var (
 type TestObject struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              TestSpec   `json:"spec"`
 	Status            TestStatus `json:"status"`
 }
 
+// +k8s:deepcopy-gen=true
+type TestSpec struct {
+	Replicas int64 `json:"replicas,omitempty"`
+}
+
 // +k8s:deepcopy-gen=true
 type TestStatus struct {
+	Replicas   int64              `json:"replicas,omitempty"`
 	Conditions []status.Condition `json:"conditions,omitempty"`
 }
 
@@ -107,3 +117,140 @@ func (in *TestStatus) DeepCopy() *TestStatus {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// TestObjectV1 is the TestObject kind served at another version
+type TestObjectV1 struct {
+	TestObject
+}
+
+func (in *TestObjectV1) DeepCopyObject() runtime.Object {
+	return &TestObjectV1{TestObject: *in.TestObject.DeepCopy()}
+}
+
+// AggregatedAPIGroup is the group of TestAggregatedObject, which is served by an aggregated API server rather than
+// as a CRD, so is registered with the scheme from its Go types rather than generated from a CRD
+const AggregatedAPIGroup = "aggregated.operators.k8s.aws"
+
+// TestAggregatedObject is a TestObject served by an aggregated API server
+type TestAggregatedObject struct {
+	TestObject
+}
+
+func (in *TestAggregatedObject) DeepCopyObject() runtime.Object {
+	return &TestAggregatedObject{TestObject: *in.TestObject.DeepCopy()}
+}
+
+// TestAccessorObject doesn't implement status.Object, and keeps its conditions in a non-standard status struct
+// +k8s:deepcopy-gen=true
+// +kubebuilder:object:root=true
+type TestAccessorObject struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Status            TestAccessorStatus `json:"status"`
+}
+
+// +k8s:deepcopy-gen=true
+type TestAccessorStatus struct {
+	Health []status.Condition `json:"health,omitempty"`
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TestAccessorObject) DeepCopyInto(out *TestAccessorObject) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TestAccessorObject.
+func (in *TestAccessorObject) DeepCopy() *TestAccessorObject {
+	if in == nil {
+		return nil
+	}
+	out := new(TestAccessorObject)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TestAccessorObject) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TestAccessorStatus) DeepCopyInto(out *TestAccessorStatus) {
+	*out = *in
+	if in.Health != nil {
+		in, out := &in.Health, &out.Health
+		*out = make([]status.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TestAccessorStatus.
+func (in *TestAccessorStatus) DeepCopy() *TestAccessorStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TestAccessorStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// TestCodecObject keeps its conditions as a custom slice type, with states expressed as an enum
+type TestCodecObject struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Status            TestCodecStatus `json:"status"`
+}
+
+type TestCodecStatus struct {
+	Checks []TestCheck `json:"checks,omitempty"`
+}
+
+type TestCheck struct {
+	Name  string         `json:"name"`
+	State TestCheckState `json:"state"`
+}
+
+type TestCheckState int
+
+const (
+	TestCheckStatePending TestCheckState = iota
+	TestCheckStatePassing
+	TestCheckStateFailing
+)
+
+func (in *TestCodecObject) DeepCopyObject() runtime.Object {
+	out := *in
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Status.Checks = slices.Clone(in.Status.Checks)
+	return &out
+}
+
+// TestCheckCodec adapts the checks of a TestCodecObject to conditions
+type TestCheckCodec struct{}
+
+var testCheckStatuses = map[TestCheckState]metav1.ConditionStatus{
+	TestCheckStatePending: metav1.ConditionUnknown,
+	TestCheckStatePassing: metav1.ConditionTrue,
+	TestCheckStateFailing: metav1.ConditionFalse,
+}
+
+func (TestCheckCodec) Decode(o *TestCodecObject) []status.Condition {
+	return lo.Map(o.Status.Checks, func(check TestCheck, _ int) status.Condition {
+		return status.Condition{Type: check.Name, Status: testCheckStatuses[check.State], Reason: check.Name}
+	})
+}
+
+func (TestCheckCodec) Encode(o *TestCodecObject, conditions []status.Condition) {
+	states := lo.Invert(testCheckStatuses)
+	o.Status.Checks = lo.Map(conditions, func(condition status.Condition, _ int) TestCheck {
+		return TestCheck{Name: condition.Type, State: states[condition.Status]}
+	})
+}
@@ -0,0 +1,74 @@
+package status
+
+import (
+	"context"
+
+	"github.com/awslabs/operatorpkg/object"
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// sweep periodically forgets objects that haven't been reconciled within the MetricTTL, until the context is done
+func (c *Controller[T]) sweep(ctx context.Context) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-c.opts.Clock.After(c.opts.MetricTTL):
+			c.sweepOnce()
+		}
+	}
+}
+
+func (c *Controller[T]) sweepOnce() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	gvk := object.GVK(object.New[T]())
+	for req, lastReconciled := range c.lastReconciled {
+		if c.opts.Clock.Since(lastReconciled) >= c.opts.MetricTTL {
+			c.forgetLocked(gvk, req)
+		}
+	}
+}
+
+// forget deletes the series and state of an object, once it's deleted or no longer reconciled
+func (c *Controller[T]) forget(gvk schema.GroupVersionKind, req reconcile.Request) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.forgetLocked(gvk, req)
+}
+
+// forgetLocked is forget for callers that hold the lock
+func (c *Controller[T]) forgetLocked(gvk schema.GroupVersionKind, req reconcile.Request) {
+	for _, metric := range c.metrics.objectMetrics() {
+		metric.DeletePartialMatch(prometheus.Labels{
+			MetricLabelGroup:     gvk.Group,
+			MetricLabelKind:      gvk.Kind,
+			MetricLabelNamespace: string(req.Namespace),
+			MetricLabelName:      string(req.Name),
+		})
+	}
+	if counted, ok := c.countedStatuses[req]; ok {
+		c.countObjectsByCondition(gvk, counted, -1)
+	}
+	delete(c.observedConditions, req)
+	delete(c.lastReconciled, req)
+	delete(c.terminatingObjects, req)
+	delete(c.lastInfoEvent, req)
+	delete(c.countedStatuses, req)
+	delete(c.contextLabels, req)
+}
+
+// forgetConditionType deletes the series of the condition type of the object
+func (c *Controller[T]) forgetConditionType(gvk schema.GroupVersionKind, req reconcile.Request, conditionType string) {
+	for _, metric := range c.metrics.conditionMetrics() {
+		metric.DeletePartialMatch(prometheus.Labels{
+			MetricLabelGroup:         gvk.Group,
+			MetricLabelKind:          gvk.Kind,
+			MetricLabelNamespace:     string(req.Namespace),
+			MetricLabelName:          string(req.Name),
+			MetricLabelConditionType: conditionType,
+		})
+	}
+}
@@ -0,0 +1,11 @@
+package status
+
+// TracerName is the name of the tracer with which reconciles of the status controller are traced
+const TracerName = "github.com/awslabs/operatorpkg/status"
+
+// Attributes of the span of each reconcile, see ControllerOpts.TracerProvider
+const (
+	SpanAttributeNamespace   = "operatorpkg.namespace"
+	SpanAttributeName        = "operatorpkg.name"
+	SpanAttributeTransitions = "operatorpkg.transitions"
+)
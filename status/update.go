@@ -0,0 +1,59 @@
+package status
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// FieldManager is the field manager used for status patches issued by this package. Override it to avoid
+// conflicts with the primary controller, or override it per call by passing client.FieldOwner.
+var FieldManager = "operatorpkg"
+
+// UpdateStatus patches the status of the object relative to the stored object, e.g.
+//
+//	stored := o.DeepCopy()
+//	o.StatusConditions().SetTrue(ConditionReady)
+//	err := status.UpdateStatus(ctx, c, o, stored)
+//
+// Merge patches are used, since CRDs don't support strategic merge patch. Types served by aggregated API servers are
+// patched the same way, but the aggregated API server must serve the status subresource of the type.
+func UpdateStatus(ctx context.Context, c client.Client, o Object, stored client.Object, opts ...client.SubResourcePatchOption) error {
+	return c.Status().Patch(ctx, o, client.MergeFrom(stored), append([]client.SubResourcePatchOption{client.FieldOwner(FieldManager)}, opts...)...)
+}
+
+// UpdateStatusIfChanged patches the conditions of the object to the desired conditions, only if they differ from the
+// conditions on the API server, ignoring transition times. This avoids resourceVersion churn from writes that don't
+// change the conditions. Returns whether the conditions were patched.
+func UpdateStatusIfChanged(ctx context.Context, c client.Client, o Object, desired ConditionSet, opts ...client.SubResourcePatchOption) (changed bool, err error) {
+	stored := o.DeepCopyObject().(Object)
+	if err := c.Get(ctx, client.ObjectKeyFromObject(o), stored); err != nil {
+		return false, fmt.Errorf("getting object, %w", err)
+	}
+	if conditionsEqual(stored.GetConditions(), desired.List()) {
+		return false, nil
+	}
+	patched := stored.DeepCopyObject().(Object)
+	patched.SetConditions(desired.List())
+	if err := UpdateStatus(ctx, c, patched, stored, opts...); err != nil {
+		return false, fmt.Errorf("patching status, %w", err)
+	}
+	return true, nil
+}
+
+// conditionsEqual returns true if the conditions are equal regardless of order, ignoring transition times
+func conditionsEqual(a, b []Condition) bool {
+	normalize := func(conditions []Condition) []Condition {
+		conditions = slices.Clone(conditions)
+		for i := range conditions {
+			conditions[i].LastTransitionTime = metav1.Time{}
+		}
+		slices.SortFunc(conditions, func(a, b Condition) int { return strings.Compare(a.Type, b.Type) })
+		return conditions
+	}
+	return slices.Equal(normalize(a), normalize(b))
+}
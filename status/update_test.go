@@ -0,0 +1,108 @@
+package status_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/awslabs/operatorpkg/status"
+	"github.com/awslabs/operatorpkg/test"
+	. "github.com/awslabs/operatorpkg/test/expectations"
+	"github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/samber/lo"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+var _ = Describe("UpdateStatus", func() {
+	var ctx context.Context
+	var kubeClient client.Client
+	var fieldManagers []string
+	BeforeEach(func() {
+		fieldManagers = nil
+		kubeClient = fake.NewClientBuilder().
+			WithScheme(scheme.Scheme).
+			WithStatusSubresource(&TestObject{}).
+			WithInterceptorFuncs(interceptor.Funcs{
+				SubResourcePatch: func(ctx context.Context, c client.Client, subResourceName string, obj client.Object, patch client.Patch, opts ...client.SubResourcePatchOption) error {
+					patchOptions := &client.SubResourcePatchOptions{}
+					patchOptions.ApplyOptions(opts)
+					fieldManagers = append(fieldManagers, patchOptions.FieldManager)
+					return c.SubResource(subResourceName).Patch(ctx, obj, patch, opts...)
+				},
+			}).
+			Build()
+		ctx = log.IntoContext(context.Background(), ginkgo.GinkgoLogr)
+	})
+
+	It("should patch status with the default field manager", func() {
+		testObject := test.Object(&TestObject{})
+		ExpectApplied(ctx, kubeClient, testObject)
+
+		stored := testObject.DeepCopy()
+		testObject.StatusConditions().SetTrue(ConditionTypeFoo)
+		Expect(status.UpdateStatus(ctx, kubeClient, testObject, stored)).To(Succeed())
+
+		Expect(fieldManagers).To(ConsistOf(status.FieldManager))
+		ExpectStatusConditions(ctx, kubeClient, FastTimeout, testObject, status.Condition{Type: ConditionTypeFoo, Status: metav1.ConditionTrue})
+	})
+	It("should patch status with a custom field manager", func() {
+		testObject := test.Object(&TestObject{})
+		ExpectApplied(ctx, kubeClient, testObject)
+
+		stored := testObject.DeepCopy()
+		testObject.StatusConditions().SetFalse(ConditionTypeFoo, "reason", "message")
+		Expect(status.UpdateStatus(ctx, kubeClient, testObject, stored, client.FieldOwner("custom"))).To(Succeed())
+
+		Expect(fieldManagers).To(ConsistOf("custom"))
+		ExpectStatusConditions(ctx, kubeClient, FastTimeout, testObject, status.Condition{Type: ConditionTypeFoo, Status: metav1.ConditionFalse, Reason: "reason"})
+	})
+	It("should wait for a condition to be updated", func() {
+		testObject := test.Object(&TestObject{})
+		ExpectApplied(ctx, kubeClient, testObject)
+
+		go func() {
+			defer GinkgoRecover()
+			time.Sleep(100 * time.Millisecond)
+			stored := testObject.DeepCopy()
+			updated := testObject.DeepCopy()
+			updated.StatusConditions().SetTrue(ConditionTypeFoo)
+			Expect(status.UpdateStatus(ctx, kubeClient, updated, stored)).To(Succeed())
+		}()
+		EventuallyCondition(ctx, kubeClient, testObject.DeepCopy(), ConditionTypeFoo, metav1.ConditionTrue, FastTimeout)
+	})
+	It("should not patch status when the conditions are unchanged", func() {
+		testObject := test.Object(&TestObject{})
+		testObject.StatusConditions().SetTrue(ConditionTypeFoo)
+		ExpectApplied(ctx, kubeClient, testObject)
+
+		// Transition times are ignored
+		desired := testObject.DeepCopy()
+		desired.Status.Conditions = lo.Map(desired.Status.Conditions, func(condition status.Condition, _ int) status.Condition {
+			condition.LastTransitionTime = metav1.NewTime(condition.LastTransitionTime.Add(time.Hour))
+			return condition
+		})
+		changed, err := status.UpdateStatusIfChanged(ctx, kubeClient, testObject, desired.StatusConditions())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(changed).To(BeFalse())
+		Expect(fieldManagers).To(BeEmpty())
+	})
+	It("should patch status when the conditions have changed", func() {
+		testObject := test.Object(&TestObject{})
+		testObject.StatusConditions().SetTrue(ConditionTypeFoo)
+		ExpectApplied(ctx, kubeClient, testObject)
+
+		desired := testObject.DeepCopy()
+		desired.StatusConditions().SetFalse(ConditionTypeFoo, "reason", "message")
+		changed, err := status.UpdateStatusIfChanged(ctx, kubeClient, testObject, desired.StatusConditions())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(changed).To(BeTrue())
+		Expect(fieldManagers).To(ConsistOf(status.FieldManager))
+		ExpectStatusConditions(ctx, kubeClient, FastTimeout, testObject, status.Condition{Type: ConditionTypeFoo, Status: metav1.ConditionFalse, Reason: "reason"})
+	})
+})
@@ -0,0 +1,175 @@
+package status
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/awslabs/operatorpkg/object"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/samber/lo"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// WebhookOpts configures the delivery of transitions by a WebhookSink
+type WebhookOpts struct {
+	// Client sends the requests, and defaults to http.DefaultClient
+	Client *http.Client
+	// Timeout bounds each attempt, and defaults to 10 seconds
+	Timeout time.Duration
+	// Retries is the number of attempts after the first has failed
+	Retries int
+	// Backoff is the delay before the first retry, which doubles for each subsequent retry, and defaults to 1 second
+	Backoff time.Duration
+	// QueueSize is the number of transitions buffered for delivery, and defaults to 100. Transitions sent while the
+	// queue is full are dropped and counted by WebhookDropped.
+	QueueSize int
+	// MetricNamespace replaces the "operator" prefix of the metric names, see ControllerOpts.MetricNamespace
+	MetricNamespace string
+	// MetricSubsystem separates the metrics of this sink from those of other sinks, see ControllerOpts.MetricSubsystem
+	MetricSubsystem string
+}
+
+// WebhookPayload is the JSON body posted by a WebhookSink for each transition
+type WebhookPayload struct {
+	Group              string                 `json:"group"`
+	Kind               string                 `json:"kind"`
+	Namespace          string                 `json:"namespace,omitempty"`
+	Name               string                 `json:"name"`
+	Type               string                 `json:"type"`
+	PreviousStatus     metav1.ConditionStatus `json:"previousStatus"`
+	Status             metav1.ConditionStatus `json:"status"`
+	Reason             string                 `json:"reason"`
+	Message            string                 `json:"message,omitempty"`
+	LastTransitionTime metav1.Time            `json:"lastTransitionTime"`
+}
+
+// WebhookSink is an EventSink that posts each transition to a URL, retrying failed deliveries with exponential
+// backoff. Deliveries that fail after all retries are dropped and counted by WebhookFailures. Transitions are queued
+// by Send and delivered in the background once the sink is started, which Controller.Register does for its sinks.
+type WebhookSink struct {
+	url      string
+	opts     WebhookOpts
+	queue    chan webhookDelivery
+	failures *prometheus.CounterVec
+	dropped  *prometheus.CounterVec
+}
+
+// webhookDelivery is a transition queued for delivery by a WebhookSink
+type webhookDelivery struct {
+	labels prometheus.Labels
+	body   []byte
+}
+
+func NewWebhookSink(url string, opts ...WebhookOpts) *WebhookSink {
+	s := &WebhookSink{url: url}
+	if len(opts) > 0 {
+		s.opts = opts[0]
+	}
+	if s.opts.Client == nil {
+		s.opts.Client = http.DefaultClient
+	}
+	if s.opts.Timeout == 0 {
+		s.opts.Timeout = time.Second * 10
+	}
+	if s.opts.Backoff == 0 {
+		s.opts.Backoff = time.Second
+	}
+	if s.opts.QueueSize == 0 {
+		s.opts.QueueSize = 100
+	}
+	if s.opts.MetricNamespace == "" {
+		s.opts.MetricNamespace = MetricNamespace
+	}
+	namespace := strings.Join(lo.Compact([]string{s.opts.MetricNamespace, s.opts.MetricSubsystem}), "_")
+	s.queue = make(chan webhookDelivery, s.opts.QueueSize)
+	s.failures = register(webhookFailuresMetric(namespace))
+	s.dropped = register(webhookDroppedMetric(namespace))
+	return s
+}
+
+// Send queues the transition for delivery, without blocking the reconcile loop
+func (s *WebhookSink) Send(ctx context.Context, event TransitionEvent) {
+	gvk := object.GVK(event.Object)
+	body := lo.Must(json.Marshal(WebhookPayload{
+		Group:              gvk.Group,
+		Kind:               gvk.Kind,
+		Namespace:          event.Object.GetNamespace(),
+		Name:               event.Object.GetName(),
+		Type:               event.Current.Type,
+		PreviousStatus:     event.Previous.Status,
+		Status:             event.Current.Status,
+		Reason:             event.Current.Reason,
+		Message:            event.Current.Message,
+		LastTransitionTime: event.Current.LastTransitionTime,
+	}))
+	delivery := webhookDelivery{
+		labels: prometheus.Labels{
+			MetricLabelGroup: gvk.Group,
+			MetricLabelKind:  gvk.Kind,
+		},
+		body: body,
+	}
+	select {
+	case s.queue <- delivery:
+	default:
+		log.FromContext(ctx).Error(fmt.Errorf("queue is full"), "dropped transition for webhook", "url", s.url)
+		s.dropped.With(delivery.labels).Inc()
+	}
+}
+
+// Start delivers the queued transitions until the context is done
+func (s *WebhookSink) Start(ctx context.Context) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case delivery := <-s.queue:
+			if err := s.deliver(ctx, delivery.body); err != nil {
+				log.FromContext(ctx).Error(err, "failed delivering transition to webhook", "url", s.url)
+				s.failures.With(delivery.labels).Inc()
+			}
+		}
+	}
+}
+
+// deliver posts the body, retrying with exponential backoff until an attempt succeeds or the retries are exhausted
+func (s *WebhookSink) deliver(ctx context.Context, body []byte) error {
+	backoff := s.opts.Backoff
+	for attempt := 0; ; attempt++ {
+		err := s.post(ctx, body)
+		if err == nil || attempt >= s.opts.Retries {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+}
+
+func (s *WebhookSink) post(ctx context.Context, body []byte) error {
+	ctx, cancel := context.WithTimeout(ctx, s.opts.Timeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("creating request, %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := s.opts.Client.Do(req)
+	if err != nil {
+		return fmt.Errorf("posting transition, %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("posting transition, unexpected status %s", resp.Status)
+	}
+	return nil
+}
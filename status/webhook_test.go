@@ -0,0 +1,116 @@
+package status_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"time"
+
+	"github.com/awslabs/operatorpkg/status"
+	"github.com/awslabs/operatorpkg/test"
+	. "github.com/awslabs/operatorpkg/test/expectations"
+	"github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+var _ = Describe("WebhookSink", func() {
+	var ctx context.Context
+	var server *httptest.Server
+	var mu sync.Mutex
+	var failures int
+	var payloads []status.WebhookPayload
+	var event status.TransitionEvent
+	BeforeEach(func() {
+		ctx = log.IntoContext(context.Background(), ginkgo.GinkgoLogr)
+		payloads = nil
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			mu.Lock()
+			defer mu.Unlock()
+			payload := status.WebhookPayload{}
+			Expect(json.NewDecoder(r.Body).Decode(&payload)).To(Succeed())
+			payloads = append(payloads, payload)
+			if failures > 0 {
+				failures--
+				w.WriteHeader(http.StatusInternalServerError)
+			}
+		}))
+		DeferCleanup(server.Close)
+		testObject := test.Object(&TestObject{})
+		event = status.TransitionEvent{
+			Object:   testObject,
+			Previous: status.Condition{Type: ConditionTypeFoo, Status: metav1.ConditionUnknown},
+			Current:  status.Condition{Type: ConditionTypeFoo, Status: metav1.ConditionTrue, Reason: "reason", LastTransitionTime: metav1.Now()},
+		}
+	})
+	webhookFailures := func() float64 {
+		return MetricValue("operator_status_webhook_failures_total", map[string]string{status.MetricLabelKind: "TestObject"})
+	}
+	received := func() []status.WebhookPayload {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]status.WebhookPayload{}, payloads...)
+	}
+	start := func(sink *status.WebhookSink) {
+		ctx, cancel := context.WithCancel(ctx)
+		DeferCleanup(cancel)
+		go func() {
+			defer GinkgoRecover()
+			Expect(sink.Start(ctx)).To(Succeed())
+		}()
+	}
+
+	It("should retry until the transition is delivered", func() {
+		failures = 2
+		count := webhookFailures()
+		sink := status.NewWebhookSink(server.URL, status.WebhookOpts{Retries: 3, Backoff: time.Millisecond})
+		start(sink)
+		sink.Send(ctx, event)
+		Eventually(received).Should(HaveLen(3))
+		Expect(received()[2]).To(And(
+			HaveField("Kind", "TestObject"),
+			HaveField("Name", event.Object.GetName()),
+			HaveField("Type", ConditionTypeFoo),
+			HaveField("PreviousStatus", metav1.ConditionUnknown),
+			HaveField("Status", metav1.ConditionTrue),
+			HaveField("Reason", "reason"),
+		))
+		Expect(webhookFailures()).To(Equal(count))
+	})
+	It("should count transitions that fail after all retries", func() {
+		failures = 10
+		count := webhookFailures()
+		sink := status.NewWebhookSink(server.URL, status.WebhookOpts{Retries: 2, Backoff: time.Millisecond})
+		start(sink)
+		sink.Send(ctx, event)
+		Eventually(webhookFailures).Should(Equal(count + 1))
+		Expect(received()).To(HaveLen(3))
+	})
+	It("should drop transitions sent while the queue is full", func() {
+		dropped := func() float64 {
+			return MetricValue("operator_status_webhook_dropped_total", map[string]string{status.MetricLabelKind: "TestObject"})
+		}
+		count := dropped()
+		// Send doesn't block while the sink isn't delivering
+		sink := status.NewWebhookSink(server.URL, status.WebhookOpts{QueueSize: 1})
+		sink.Send(ctx, event)
+		sink.Send(ctx, event)
+		Expect(dropped()).To(Equal(count + 1))
+
+		start(sink)
+		Eventually(received).Should(HaveLen(1))
+	})
+	It("should emit failures with the configured metric namespace", func() {
+		failures = 1
+		sink := status.NewWebhookSink(server.URL, status.WebhookOpts{MetricNamespace: "karpenter", MetricSubsystem: "nodepool"})
+		start(sink)
+		sink.Send(ctx, event)
+		Eventually(func() float64 {
+			return MetricValue("karpenter_nodepool_status_webhook_failures_total", map[string]string{status.MetricLabelKind: "TestObject"})
+		}).Should(BeNumerically(">", 0))
+	})
+})
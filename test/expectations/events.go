@@ -0,0 +1,34 @@
+package test
+
+import (
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/client-go/tools/record"
+)
+
+// ExpectEvent receives the next event from the recorder, and expects it to have the reason and to contain each of
+// the substrings, so that assertions are resilient to changes to the wording of the message. Events are formatted
+// by the FakeRecorder as "<type> <reason> <message>".
+func ExpectEvent(recorder *record.FakeRecorder, reason string, substrings ...string) string {
+	GinkgoHelper()
+	var event string
+	Eventually(recorder.Events).WithTimeout(FastTimeout).WithPolling(FastPolling).Should(Receive(&event), "expected an event with reason %s", reason)
+	fields := strings.SplitN(event, " ", 3)
+	Expect(fields).To(HaveLen(3), "expected event %q to have a type, reason and message", event)
+	Expect(fields[1]).To(Equal(reason), "expected event %q to have reason %s", event, reason)
+	for _, substring := range substrings {
+		Expect(fields[2]).To(ContainSubstring(substring), "expected event %q to contain %q", event, substring)
+	}
+	return event
+}
+
+// ExpectNoEvents expects the recorder to not receive an event within a short timeout
+func ExpectNoEvents(recorder *record.FakeRecorder) {
+	GinkgoHelper()
+	var event string
+	Consistently(recorder.Events).WithTimeout(FastTimeout/10).WithPolling(FastPolling).ShouldNot(Receive(&event), func() string {
+		return "expected no events, received " + event
+	})
+}
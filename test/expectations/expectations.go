@@ -16,6 +16,7 @@ import (
 	"github.com/samber/lo"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -128,6 +129,31 @@ func ExpectStatusConditions(ctx context.Context, c client.Client, timeout time.D
 		Should(Succeed())
 }
 
+// EventuallyCondition polls the API server until the condition of the object has the desired status, e.g. while a
+// controller running against envtest reconciles the object, and fails with the last observed condition on timeout.
+func EventuallyCondition(ctx context.Context, c client.Client, obj status.Object, conditionType string, conditionStatus metav1.ConditionStatus, timeout time.Duration) {
+	GinkgoHelper()
+	var observed *status.Condition
+	Eventually(func() (metav1.ConditionStatus, error) {
+		if err := c.Get(ctx, client.ObjectKeyFromObject(obj), obj); err != nil {
+			return "", err
+		}
+		observed = obj.StatusConditions().Get(conditionType)
+		if observed == nil {
+			return "", nil
+		}
+		return observed.Status, nil
+	}).
+		WithTimeout(timeout).
+		WithPolling(timeout/20).
+		Should(Equal(conditionStatus), func() string {
+			if observed == nil {
+				return fmt.Sprintf("expected %s to have condition %s=%s, but the condition is not set", object.GVKNN(obj), conditionType, conditionStatus)
+			}
+			return fmt.Sprintf("expected %s to have condition %s=%s, but it is %s with reason %q and message %q", object.GVKNN(obj), conditionType, conditionStatus, observed.Status, observed.Reason, observed.Message)
+		})
+}
+
 func ExpectStatusUpdated(ctx context.Context, c client.Client, objects ...client.Object) {
 	GinkgoHelper()
 	for _, o := range objects {
@@ -0,0 +1,37 @@
+package test
+
+import (
+	"fmt"
+
+	"github.com/awslabs/operatorpkg/status"
+	"github.com/onsi/gomega/gcustom"
+	"github.com/onsi/gomega/types"
+	"github.com/samber/lo"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const conditionMatcherTemplate = "Expected object {{.Actual.GetNamespace}}/{{.Actual.GetName}} {{.To}} have condition {{.Data}}, but has conditions:\n{{format .Actual.GetConditions 1}}"
+
+// HaveCondition succeeds if the object has a condition of the type with the status, e.g.
+//
+//	Expect(obj).To(HaveCondition(status.ConditionReady, metav1.ConditionTrue))
+func HaveCondition(conditionType string, conditionStatus metav1.ConditionStatus) types.GomegaMatcher {
+	return gcustom.MakeMatcher(func(obj status.Object) (bool, error) {
+		condition, found := findCondition(obj, conditionType)
+		return found && condition.Status == conditionStatus, nil
+	}).WithTemplate(conditionMatcherTemplate, fmt.Sprintf("%s=%s", conditionType, conditionStatus))
+}
+
+// HaveConditionReason succeeds if the object has a condition of the type with the reason, e.g.
+//
+//	Expect(obj).To(HaveConditionReason(status.ConditionReady, "UnhealthyDependents"))
+func HaveConditionReason(conditionType string, reason string) types.GomegaMatcher {
+	return gcustom.MakeMatcher(func(obj status.Object) (bool, error) {
+		condition, found := findCondition(obj, conditionType)
+		return found && condition.Reason == reason, nil
+	}).WithTemplate(conditionMatcherTemplate, fmt.Sprintf("%s with reason %s", conditionType, reason))
+}
+
+func findCondition(obj status.Object, conditionType string) (status.Condition, bool) {
+	return lo.Find(obj.GetConditions(), func(condition status.Condition) bool { return condition.Type == conditionType })
+}
@@ -0,0 +1,48 @@
+package test_test
+
+import (
+	"github.com/awslabs/operatorpkg/status"
+	"github.com/awslabs/operatorpkg/test"
+	. "github.com/awslabs/operatorpkg/test/expectations"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("Matchers", func() {
+	var testObject *TestObject
+	BeforeEach(func() {
+		testObject = test.Object(&TestObject{})
+		testObject.StatusConditions().SetFalse(ConditionTypeFoo, "reason", "message")
+	})
+
+	It("should match conditions by status", func() {
+		Expect(testObject).To(HaveCondition(ConditionTypeFoo, metav1.ConditionFalse))
+		Expect(testObject).To(HaveCondition(status.ConditionReady, metav1.ConditionFalse))
+		Expect(testObject).ToNot(HaveCondition(ConditionTypeFoo, metav1.ConditionTrue))
+		Expect(testObject).ToNot(HaveCondition(ConditionTypeBaz, metav1.ConditionFalse))
+	})
+	It("should match conditions by reason", func() {
+		Expect(testObject).To(HaveConditionReason(ConditionTypeFoo, "reason"))
+		Expect(testObject).To(HaveConditionReason(status.ConditionReady, "UnhealthyDependents"))
+		Expect(testObject).ToNot(HaveConditionReason(ConditionTypeFoo, "other"))
+		Expect(testObject).ToNot(HaveConditionReason(ConditionTypeBaz, "reason"))
+	})
+	It("should explain failures with the object's conditions", func() {
+		matcher := HaveCondition(ConditionTypeFoo, metav1.ConditionTrue)
+		Expect(matcher.Match(testObject)).To(BeFalse())
+		Expect(matcher.FailureMessage(testObject)).To(And(
+			ContainSubstring("Expected object %s/%s to have condition Foo=True, but has conditions:", testObject.Namespace, testObject.Name),
+			ContainSubstring("Reason: \"reason\""),
+		))
+		Expect(matcher.NegatedFailureMessage(testObject)).To(ContainSubstring("not to have condition Foo=True"))
+
+		matcher = HaveConditionReason(ConditionTypeFoo, "other")
+		Expect(matcher.Match(testObject)).To(BeFalse())
+		Expect(matcher.FailureMessage(testObject)).To(ContainSubstring("to have condition Foo with reason other"))
+	})
+	It("should fail for objects without conditions", func() {
+		_, err := HaveCondition(ConditionTypeFoo, metav1.ConditionTrue).Match(&metav1.ObjectMeta{})
+		Expect(err).To(HaveOccurred())
+	})
+})
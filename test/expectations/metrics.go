@@ -0,0 +1,101 @@
+package test
+
+import (
+	"fmt"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/samber/lo"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+// FindMetric returns the first series of the metric in the controller-runtime registry whose labels include the
+// labels, or nil if no series matches
+func FindMetric(name string, labels map[string]string) *dto.Metric {
+	family, found := lo.Find(lo.Must(metrics.Registry.Gather()), func(family *dto.MetricFamily) bool { return family.GetName() == name })
+	if !found {
+		return nil
+	}
+	m, _ := lo.Find(family.GetMetric(), func(m *dto.Metric) bool { return matchesLabels(m, labels) })
+	return m
+}
+
+// MetricValue returns the value of the first series of the metric whose labels include the labels, or 0 if no
+// series matches, e.g. to read a counter before an expected delta. The value of a histogram or summary is its sample
+// count.
+func MetricValue(name string, labels map[string]string) float64 {
+	if m := FindMetric(name, labels); m != nil {
+		return metricValue(m)
+	}
+	return 0
+}
+
+// MetricSum returns the sample sum of the first series of the histogram or summary whose labels include the labels,
+// or 0 if no series matches
+func MetricSum(name string, labels map[string]string) float64 {
+	m := FindMetric(name, labels)
+	if m.GetSummary() != nil {
+		return m.GetSummary().GetSampleSum()
+	}
+	return m.GetHistogram().GetSampleSum()
+}
+
+// ExpectMetricValue expects a series of the metric whose labels include the labels to have the value. The value of
+// a histogram or summary is its sample count.
+func ExpectMetricValue(name string, labels map[string]string, expected float64) {
+	GinkgoHelper()
+	m := FindMetric(name, labels)
+	Expect(m).ToNot(BeNil(), func() string {
+		return fmt.Sprintf("expected a series of %s with labels %v, found series with labels\n%s", name, labels, describeSeries(name))
+	})
+	Expect(metricValue(m)).To(BeNumerically("~", expected), func() string {
+		return fmt.Sprintf("expected the series of %s with labels %v to have value %v", name, seriesLabels(m), expected)
+	})
+}
+
+// ExpectMetricNotFound expects no series of the metric to have labels that include the labels
+func ExpectMetricNotFound(name string, labels map[string]string) {
+	GinkgoHelper()
+	m := FindMetric(name, labels)
+	Expect(m).To(BeNil(), func() string {
+		return fmt.Sprintf("expected no series of %s with labels %v, found series with labels %v", name, labels, seriesLabels(m))
+	})
+}
+
+func matchesLabels(m *dto.Metric, labels map[string]string) bool {
+	actual := seriesLabels(m)
+	return lo.EveryBy(lo.Entries(labels), func(label lo.Entry[string, string]) bool {
+		value, ok := actual[label.Key]
+		return ok && value == label.Value
+	})
+}
+
+func seriesLabels(m *dto.Metric) map[string]string {
+	return lo.SliceToMap(m.GetLabel(), func(label *dto.LabelPair) (string, string) { return label.GetName(), label.GetValue() })
+}
+
+func metricValue(m *dto.Metric) float64 {
+	switch {
+	case m.Gauge != nil:
+		return m.GetGauge().GetValue()
+	case m.Counter != nil:
+		return m.GetCounter().GetValue()
+	case m.Histogram != nil:
+		return float64(m.GetHistogram().GetSampleCount())
+	case m.Summary != nil:
+		return float64(m.GetSummary().GetSampleCount())
+	default:
+		return m.GetUntyped().GetValue()
+	}
+}
+
+// describeSeries lists the labels of each series of the metric, for failure messages
+func describeSeries(name string) string {
+	family, found := lo.Find(lo.Must(metrics.Registry.Gather()), func(family *dto.MetricFamily) bool { return family.GetName() == name })
+	if !found {
+		return "(metric not found)"
+	}
+	return strings.Join(lo.Map(family.GetMetric(), func(m *dto.Metric, _ int) string { return fmt.Sprint(seriesLabels(m)) }), "\n")
+}
@@ -0,0 +1,48 @@
+package test_test
+
+import (
+	. "github.com/awslabs/operatorpkg/test/expectations"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+var _ = Describe("Metrics", func() {
+	var gauge *prometheus.GaugeVec
+	BeforeEach(func() {
+		gauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{Name: "expectations_test_gauge"}, []string{"kind", "name"})
+		metrics.Registry.MustRegister(gauge)
+		DeferCleanup(func() { metrics.Registry.Unregister(gauge) })
+		gauge.With(prometheus.Labels{"kind": "TestObject", "name": "foo"}).Set(1)
+		gauge.With(prometheus.Labels{"kind": "TestObject", "name": "bar"}).Set(2)
+	})
+
+	It("should expect the value of the series matching the labels", func() {
+		ExpectMetricValue("expectations_test_gauge", map[string]string{"name": "bar"}, 2)
+		ExpectMetricNotFound("expectations_test_gauge", map[string]string{"name": "baz"})
+	})
+	It("should read missing series as zero", func() {
+		Expect(MetricValue("expectations_test_gauge", map[string]string{"name": "foo"})).To(Equal(1.0))
+		Expect(MetricValue("expectations_test_gauge", map[string]string{"name": "baz"})).To(BeZero())
+		Expect(MetricSum("expectations_test_histogram", nil)).To(BeZero())
+	})
+	It("should list the labels of the series that didn't match", func() {
+		failure := InterceptGomegaFailure(func() {
+			ExpectMetricValue("expectations_test_gauge", map[string]string{"name": "baz"}, 1)
+		})
+		Expect(failure).To(HaveOccurred())
+		Expect(failure.Error()).To(And(
+			ContainSubstring("expected a series of expectations_test_gauge with labels map[name:baz]"),
+			ContainSubstring("map[kind:TestObject name:foo]"),
+			ContainSubstring("map[kind:TestObject name:bar]"),
+		))
+	})
+	It("should list the labels of the series that matched unexpectedly", func() {
+		failure := InterceptGomegaFailure(func() {
+			ExpectMetricNotFound("expectations_test_gauge", map[string]string{"name": "foo"})
+		})
+		Expect(failure).To(HaveOccurred())
+		Expect(failure.Error()).To(ContainSubstring("found series with labels map[kind:TestObject name:foo]"))
+	})
+})
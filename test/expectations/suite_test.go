@@ -0,0 +1,53 @@
+package test_test
+
+import (
+	"testing"
+
+	"github.com/awslabs/operatorpkg/status"
+	"github.com/onsi/ginkgo/v2"
+	"github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func Test(t *testing.T) {
+	gomega.RegisterFailHandler(ginkgo.Fail)
+	ginkgo.RunSpecs(t, "Expectations")
+}
+
+type TestObject struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Status            TestStatus `json:"status"`
+}
+
+type TestStatus struct {
+	Conditions []status.Condition `json:"conditions,omitempty"`
+}
+
+const (
+	// Normal Conditions
+	ConditionTypeFoo = "Foo"
+	ConditionTypeBar = "Bar"
+	// Abnormal Conditions
+	ConditionTypeBaz = "Baz"
+)
+
+func (t *TestObject) StatusConditions() status.ConditionSet {
+	return status.NewReadyConditions(ConditionTypeFoo, ConditionTypeBar).For(t)
+}
+
+func (t *TestObject) GetConditions() []status.Condition {
+	return t.Status.Conditions
+}
+
+func (t *TestObject) SetConditions(conditions []status.Condition) {
+	t.Status.Conditions = conditions
+}
+
+func (in *TestObject) DeepCopyObject() runtime.Object {
+	out := *in
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Status.Conditions = append([]status.Condition{}, in.Status.Conditions...)
+	return &out
+}